@@ -4,6 +4,7 @@ go 1.25.5
 
 require (
 	github.com/nobl9/govy v0.26.0
+	go.yaml.in/yaml/v3 v3.0.3
 	sigs.k8s.io/yaml v1.6.0
 )
 
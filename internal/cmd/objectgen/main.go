@@ -0,0 +1,442 @@
+// Package main implements objectgen, a code generator which produces DeepCopy and Equal methods
+// for every [openslo.Object] defined in a Go package and for all the named types these objects are composed of.
+//
+// It lives in a separate module, so that its dependencies are not required by the SDK.
+// It is intended to be run with 'go generate' from within the package directory:
+//
+//	//go:generate go -C ../../../internal/cmd/objectgen run . -dir $PWD
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	opensloPkgPath  = "github.com/OpenSLO/go-sdk/pkg/openslo"
+	internalPkgPath = "github.com/OpenSLO/go-sdk/internal"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("objectgen: ")
+	dir := flag.String("dir", ".", "directory of the package to generate the code for")
+	output := flag.String("output", "zz_generated.go", "name of the generated file")
+	flag.Parse()
+
+	if err := run(*dir, *output); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(dir, output string) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	outputPath := filepath.Join(dir, output)
+	pkg, err := loadPackage(dir, outputPath)
+	if err != nil {
+		return err
+	}
+	g, err := newGenerator(pkg)
+	if err != nil {
+		return err
+	}
+	src, err := g.generate()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(outputPath, src, 0o600)
+}
+
+// loadPackage loads the package from the provided directory.
+// The previously generated file is replaced with an empty one, so that stale code does not interfere.
+// Type errors of the package itself are ignored, as the hand-written code may use the generated methods.
+func loadPackage(dir, outputPath string) (*packages.Package, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedImports | packages.NeedDeps,
+		Dir:  dir,
+	}
+	if _, err := os.Stat(outputPath); err == nil {
+		pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName, Dir: dir}, ".")
+		if err != nil {
+			return nil, err
+		}
+		cfg.Overlay = map[string][]byte{outputPath: []byte("package " + pkgs[0].Name + "\n")}
+	}
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return nil, err
+	}
+	pkg := pkgs[0]
+	var failed bool
+	packages.Visit(pkgs, nil, func(p *packages.Package) {
+		for _, err := range p.Errors {
+			if p == pkg && err.Kind == packages.TypeError {
+				continue
+			}
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+		}
+	})
+	if failed {
+		return nil, fmt.Errorf("failed to load package")
+	}
+	return pkg, nil
+}
+
+type generator struct {
+	pkg     *types.Package
+	named   []*types.Named
+	visited map[*types.Named]bool
+	imports map[string]string
+	buf     bytes.Buffer
+	// depth is used to generate unique variable names in nested loops.
+	depth int
+}
+
+func newGenerator(pkg *packages.Package) (*generator, error) {
+	opensloPkg, ok := pkg.Imports[opensloPkgPath]
+	if !ok {
+		return nil, fmt.Errorf("package %s does not import %s", pkg.PkgPath, opensloPkgPath)
+	}
+	objectIface, ok := opensloPkg.Types.Scope().Lookup("Object").Type().Underlying().(*types.Interface)
+	if !ok {
+		return nil, fmt.Errorf("%s.Object is not an interface", opensloPkgPath)
+	}
+	g := &generator{
+		pkg:     pkg.Types,
+		visited: make(map[*types.Named]bool),
+		imports: make(map[string]string),
+	}
+	scope := pkg.Types.Scope()
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || typeName.IsAlias() {
+			continue
+		}
+		named, ok := typeName.Type().(*types.Named)
+		if !ok || types.IsInterface(named) || !types.Implements(named, objectIface) {
+			continue
+		}
+		if err := g.collect(named); err != nil {
+			return nil, err
+		}
+	}
+	if len(g.named) == 0 {
+		return nil, fmt.Errorf("package %s does not define any %s.Object", pkg.PkgPath, opensloPkgPath)
+	}
+	slices.SortFunc(g.named, func(a, b *types.Named) int { return strings.Compare(a.Obj().Name(), b.Obj().Name()) })
+	return g, nil
+}
+
+// collect registers the named type and all the named types it is composed of,
+// which are defined in the generated package and are not basic types.
+func (g *generator) collect(named *types.Named) error {
+	if g.visited[named] {
+		return nil
+	}
+	g.visited[named] = true
+	if _, ok := named.Underlying().(*types.Basic); ok {
+		return nil
+	}
+	g.named = append(g.named, named)
+	return g.collectType(named.Underlying())
+}
+
+func (g *generator) collectType(typ types.Type) error {
+	switch t := typ.(type) {
+	case *types.Alias:
+		return g.collectType(types.Unalias(t))
+	case *types.Named:
+		if t.Obj().Pkg() != g.pkg {
+			if _, ok := t.Underlying().(*types.Struct); ok {
+				return fmt.Errorf("unsupported struct type from another package: %s", t)
+			}
+			return g.collectType(t.Underlying())
+		}
+		return g.collect(t)
+	case *types.Basic:
+		return nil
+	case *types.Pointer:
+		return g.collectType(t.Elem())
+	case *types.Slice:
+		return g.collectType(t.Elem())
+	case *types.Map:
+		if err := g.collectType(t.Key()); err != nil {
+			return err
+		}
+		return g.collectType(t.Elem())
+	case *types.Interface:
+		if !t.Empty() {
+			return fmt.Errorf("unsupported non-empty interface type: %s", t)
+		}
+		return nil
+	case *types.Struct:
+		for i := range t.NumFields() {
+			if err := g.collectType(t.Field(i).Type()); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported type: %s", t)
+	}
+}
+
+func (g *generator) generate() ([]byte, error) {
+	var body bytes.Buffer
+	for _, named := range g.named {
+		g.buf.Reset()
+		g.generateDeepCopy(named)
+		g.generateEqual(named)
+		body.Write(g.buf.Bytes())
+	}
+	var out bytes.Buffer
+	out.WriteString("// Code generated by objectgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", g.pkg.Name())
+	if len(g.imports) > 0 {
+		paths := make([]string, 0, len(g.imports))
+		for path := range g.imports {
+			paths = append(paths, path)
+		}
+		// Standard library imports are grouped before the other imports.
+		slices.SortFunc(paths, func(a, b string) int {
+			if isStd(a) != isStd(b) {
+				if isStd(a) {
+					return -1
+				}
+				return 1
+			}
+			return strings.Compare(a, b)
+		})
+		out.WriteString("import (\n")
+		for i, path := range paths {
+			if i > 0 && isStd(path) != isStd(paths[i-1]) {
+				out.WriteString("\n")
+			}
+			fmt.Fprintf(&out, "\t%q\n", path)
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(body.Bytes())
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w\n%s", err, out.String())
+	}
+	return src, nil
+}
+
+func (g *generator) generateDeepCopy(named *types.Named) {
+	name := named.Obj().Name()
+	g.printf("// DeepCopy returns a deep copy of the %s.\n", name)
+	g.printf("func (in %s) DeepCopy() %s {\n", name, name)
+	if !g.needsCopy(named) {
+		g.printf("return in\n}\n\n")
+		return
+	}
+	g.printf("out := in\n")
+	switch t := named.Underlying().(type) {
+	case *types.Struct:
+		for i := range t.NumFields() {
+			field := t.Field(i)
+			g.copyStatements("out."+field.Name(), "in."+field.Name(), field.Type())
+		}
+	default:
+		g.copyStatements("out", "in", t)
+	}
+	g.printf("return out\n")
+	g.printf("}\n\n")
+}
+
+func (g *generator) generateEqual(named *types.Named) {
+	name := named.Obj().Name()
+	g.printf("// Equal reports whether the %s is deeply equal to the other %[1]s.\n", name)
+	g.printf("// Nil and empty slices and maps are considered equal.\n")
+	g.printf("func (in %s) Equal(other %s) bool {\n", name, name)
+	switch t := named.Underlying().(type) {
+	case *types.Struct:
+		for i := range t.NumFields() {
+			field := t.Field(i)
+			g.equalStatements("in."+field.Name(), "other."+field.Name(), field.Type())
+		}
+	default:
+		g.equalStatements("in", "other", t)
+	}
+	g.printf("return true\n")
+	g.printf("}\n\n")
+}
+
+// copyStatements writes the statements which turn dst, a shallow copy of src, into a deep copy of src.
+func (g *generator) copyStatements(dst, src string, typ types.Type) {
+	if !g.needsCopy(typ) {
+		return
+	}
+	if expr, ok := g.copyExpression(src, typ); ok {
+		g.printf("%s = %s\n", dst, expr)
+		return
+	}
+	g.depth++
+	defer func() { g.depth-- }()
+	switch t := typ.Underlying().(type) {
+	case *types.Pointer:
+		p := g.varName("ptr")
+		g.printf("if %s != nil {\n", src)
+		if expr, ok := g.copyExpression("*"+src, t.Elem()); ok {
+			g.printf("%s := %s\n", p, expr)
+		} else {
+			g.printf("%s := *%s\n", p, src)
+			g.copyStatements(p, "*"+src, t.Elem())
+		}
+		g.printf("%s = &%s\n", dst, p)
+		g.printf("}\n")
+	case *types.Slice:
+		i := g.varName("i")
+		g.printf("if %s != nil {\n", src)
+		g.printf("%s = make(%s, len(%s))\n", dst, g.typeString(typ), src)
+		g.printf("copy(%s, %s)\n", dst, src)
+		if g.needsCopy(t.Elem()) {
+			g.printf("for %s := range %s {\n", i, src)
+			g.copyStatements(operand(dst)+"["+i+"]", operand(src)+"["+i+"]", t.Elem())
+			g.printf("}\n")
+		}
+		g.printf("}\n")
+	case *types.Map:
+		k, v := g.varName("key"), g.varName("val")
+		g.printf("if %s != nil {\n", src)
+		g.printf("%s = make(%s, len(%s))\n", dst, g.typeString(typ), src)
+		g.printf("for %s, %s := range %s {\n", k, v, src)
+		if expr, ok := g.copyExpression(v, t.Elem()); ok {
+			g.printf("%s[%s] = %s\n", operand(dst), k, expr)
+		} else {
+			c := g.varName("elem")
+			g.printf("%s := %s\n", c, v)
+			g.copyStatements(c, v, t.Elem())
+			g.printf("%s[%s] = %s\n", operand(dst), k, c)
+		}
+		g.printf("}\n")
+		g.printf("}\n")
+	}
+}
+
+// copyExpression returns an expression which evaluates to a deep copy of src, if such exists.
+func (g *generator) copyExpression(src string, typ types.Type) (string, bool) {
+	if named, ok := typ.(*types.Named); ok && g.needsMethods(named) {
+		return operand(src) + ".DeepCopy()", true
+	}
+	if _, ok := typ.Underlying().(*types.Interface); ok {
+		return g.importName(internalPkgPath) + ".DeepCopyAny(" + src + ")", true
+	}
+	return src, !g.needsCopy(typ)
+}
+
+// equalStatements writes the statements which return false if a and b are not deeply equal.
+func (g *generator) equalStatements(a, b string, typ types.Type) {
+	if named, ok := typ.(*types.Named); ok && g.needsMethods(named) {
+		g.printf("if !%s.Equal(%s) {\nreturn false\n}\n", operand(a), b)
+		return
+	}
+	g.depth++
+	defer func() { g.depth-- }()
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		g.printf("if %s != %s {\nreturn false\n}\n", a, b)
+	case *types.Pointer:
+		g.printf("if (%s == nil) != (%s == nil) {\nreturn false\n}\n", a, b)
+		g.printf("if %s != nil {\n", a)
+		g.equalStatements("*"+a, "*"+b, t.Elem())
+		g.printf("}\n")
+	case *types.Slice:
+		i := g.varName("i")
+		g.printf("if len(%s) != len(%s) {\nreturn false\n}\n", a, b)
+		g.printf("for %s := range %s {\n", i, a)
+		g.equalStatements(operand(a)+"["+i+"]", operand(b)+"["+i+"]", t.Elem())
+		g.printf("}\n")
+	case *types.Map:
+		k, va, vb := g.varName("key"), g.varName("a"), g.varName("b")
+		g.printf("if len(%s) != len(%s) {\nreturn false\n}\n", a, b)
+		g.printf("for %s, %s := range %s {\n", k, va, a)
+		g.printf("%s, ok := %s[%s]\n", vb, operand(b), k)
+		g.printf("if !ok {\nreturn false\n}\n")
+		g.equalStatements(va, vb, t.Elem())
+		g.printf("}\n")
+	case *types.Interface:
+		g.printf("if !%s.DeepEqual(%s, %s) {\nreturn false\n}\n", g.importName("reflect"), a, b)
+	}
+}
+
+// operand wraps dereference expressions in parentheses, so that they can be used as operands of
+// selector and index expressions.
+func operand(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		return "(" + expr + ")"
+	}
+	return expr
+}
+
+// needsCopy reports whether the type holds any references which have to be copied.
+func (g *generator) needsCopy(typ types.Type) bool {
+	return g.needsCopyVisited(typ, make(map[types.Type]bool))
+}
+
+func (g *generator) needsCopyVisited(typ types.Type, visited map[types.Type]bool) bool {
+	if visited[typ] {
+		return false
+	}
+	visited[typ] = true
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		return false
+	case *types.Struct:
+		for i := range t.NumFields() {
+			if g.needsCopyVisited(t.Field(i).Type(), visited) {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+// needsMethods reports whether DeepCopy and Equal methods are generated for the named type.
+func (g *generator) needsMethods(named *types.Named) bool {
+	return slices.Contains(g.named, named)
+}
+
+func (g *generator) typeString(typ types.Type) string {
+	return types.TypeString(typ, func(pkg *types.Package) string {
+		if pkg == g.pkg {
+			return ""
+		}
+		return g.importName(pkg.Path())
+	})
+}
+
+func (g *generator) importName(path string) string {
+	g.imports[path] = path
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+func (g *generator) varName(prefix string) string {
+	return fmt.Sprintf("%s%d", prefix, g.depth)
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func isStd(path string) bool {
+	return !strings.Contains(strings.Split(path, "/")[0], ".")
+}
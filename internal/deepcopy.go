@@ -0,0 +1,28 @@
+package internal
+
+// DeepCopyAny returns a deep copy of a value decoded from JSON or YAML.
+// Nested map[string]any and []any values are copied recursively, any other values are returned as is.
+func DeepCopyAny(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		if t == nil {
+			return t
+		}
+		out := make(map[string]any, len(t))
+		for key, value := range t {
+			out[key] = DeepCopyAny(value)
+		}
+		return out
+	case []any:
+		if t == nil {
+			return t
+		}
+		out := make([]any, len(t))
+		for i, value := range t {
+			out[i] = DeepCopyAny(value)
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,28 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal"
+	"github.com/OpenSLO/go-sdk/internal/assert"
+)
+
+func TestDeepCopyAny(t *testing.T) {
+	value := map[string]any{
+		"query":  "up",
+		"labels": []any{"a", map[string]any{"b": 1.0}},
+	}
+
+	copied := internal.DeepCopyAny(value).(map[string]any)
+	assert.Equal(t, value, copied)
+
+	copied["query"] = "down"
+	copied["labels"].([]any)[1].(map[string]any)["b"] = 2.0
+
+	assert.Equal(t, map[string]any{
+		"query":  "up",
+		"labels": []any{"a", map[string]any{"b": 1.0}},
+	}, value)
+	assert.Equal(t, nil, internal.DeepCopyAny(nil))
+	assert.Equal(t, map[string]any(nil), internal.DeepCopyAny(map[string]any(nil)))
+}
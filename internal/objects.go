@@ -9,14 +9,20 @@ import (
 
 // GetObjectName returns a pretty-formatted name of the [openslo.Object].
 func GetObjectName[T openslo.Object](o T) string {
-	version := o.GetVersion().String()
-	i := strings.Index(version, "/")
+	return FormatObjectName(o.GetVersion(), o.GetKind(), o.GetName())
+}
+
+// FormatObjectName returns a pretty-formatted name of an [openslo.Object]
+// identified by its version, kind and name.
+func FormatObjectName(version openslo.Version, kind openslo.Kind, name string) string {
+	v := version.String()
+	i := strings.Index(v, "/")
 	if i == -1 {
 		return ""
 	}
-	version = version[i+1:]
-	if name := o.GetName(); name != "" {
-		return fmt.Sprintf("%s.%s '%s'", version, o.GetKind(), o.GetName())
+	v = v[i+1:]
+	if name != "" {
+		return fmt.Sprintf("%s.%s '%s'", v, kind, name)
 	}
-	return fmt.Sprintf("%s.%s", version, o.GetKind())
+	return fmt.Sprintf("%s.%s", v, kind)
 }
@@ -0,0 +1,16 @@
+package internal
+
+import "strconv"
+
+// PercentToRatio converts a percentage, e.g. 99.9, to a ratio, e.g. 0.999.
+// Unlike a plain division by 100, which would produce 0.9990000000000001 for 99.9,
+// the result is the float64 closest to the decimal value of the ratio.
+func PercentToRatio(percent float64) float64 {
+	// The shortest decimal representation is divided by 100 by shifting its exponent,
+	// which is exact, only the final parsing rounds the value.
+	ratio, err := strconv.ParseFloat(strconv.FormatFloat(percent, 'g', -1, 64)+"e-2", 64)
+	if err != nil {
+		return percent / 100
+	}
+	return ratio
+}
@@ -0,0 +1,31 @@
+package internal_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal"
+	"github.com/OpenSLO/go-sdk/internal/assert"
+)
+
+func TestPercentToRatio(t *testing.T) {
+	tests := []struct {
+		percent  float64
+		expected float64
+	}{
+		{99, 0.99},
+		{99.5, 0.995},
+		{99.9, 0.999},
+		{99.99, 0.9999},
+		{99.999, 0.99999},
+		{0.1, 0.001},
+		{0, 0},
+		{-5, -0.05},
+		{1e21, 1e19},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, internal.PercentToRatio(tc.percent))
+	}
+	assert.True(t, math.IsNaN(internal.PercentToRatio(math.NaN())))
+	assert.Equal(t, math.Inf(1), internal.PercentToRatio(math.Inf(1)))
+}
@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TimeWindow is a version-agnostic representation of an SLO time window.
+// It is used to share the time window bounds computation between OpenSLO versions.
+type TimeWindow struct {
+	// Unit is the duration shorthand unit, one of: m, h, d, w, M, Q, Y.
+	Unit  string
+	Value int
+	// IsRolling is true for rolling time windows.
+	IsRolling bool
+	// StartTime and TimeZone are only used for calendar-aligned time windows.
+	StartTime string
+	TimeZone  string
+}
+
+// Bounds returns the start (inclusive) and end (exclusive) of the time window which contains t.
+//
+// Rolling time windows end at t and start one duration before t.
+// Calendar-aligned time windows are consecutive periods of the time window's duration,
+// anchored at the calendar start time, the returned bounds are in the calendar's time zone.
+//
+// Days, weeks, months, quarters and years follow the calendar,
+// which means they respect daylight saving time changes and the actual length of months and years.
+// Similarly to [time.Time.AddDate], days overflowing a month are normalized,
+// e.g. one month after January 31 is March 3 (or 2 in a leap year).
+func (w TimeWindow) Bounds(t time.Time) (start, end time.Time, err error) {
+	if w.Value <= 0 {
+		return start, end, fmt.Errorf("time window duration must be positive, got: %d%s", w.Value, w.Unit)
+	}
+	if _, err = w.add(t, 0); err != nil {
+		return start, end, err
+	}
+	if w.IsRolling {
+		start, _ = w.add(t, -1)
+		return start, t, nil
+	}
+	if w.StartTime == "" {
+		return start, end, errors.New("calendar start time must be set for calendar-aligned time window")
+	}
+	location, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return start, end, fmt.Errorf("invalid calendar time zone: %w", err)
+	}
+	anchor, err := time.ParseInLocation(time.DateTime, w.StartTime, location)
+	if err != nil {
+		return start, end, fmt.Errorf("invalid calendar start time: %w", err)
+	}
+	t = t.In(location)
+	// Estimate the number of periods since the anchor and correct it,
+	// since calendar units have varying lengths.
+	approx, _ := w.add(time.Time{}, 1)
+	n := int(t.Sub(anchor) / approx.Sub(time.Time{}))
+	for {
+		start, _ = w.add(anchor, n)
+		if start.After(t) {
+			n--
+			continue
+		}
+		end, _ = w.add(anchor, n+1)
+		if !end.After(t) {
+			n++
+			continue
+		}
+		return start, end, nil
+	}
+}
+
+// add returns t shifted by n durations of the time window.
+func (w TimeWindow) add(t time.Time, n int) (time.Time, error) {
+	v := w.Value * n
+	switch w.Unit {
+	case "m":
+		return t.Add(time.Duration(v) * time.Minute), nil
+	case "h":
+		return t.Add(time.Duration(v) * time.Hour), nil
+	case "d":
+		return t.AddDate(0, 0, v), nil
+	case "w":
+		return t.AddDate(0, 0, 7*v), nil
+	case "M":
+		return t.AddDate(0, v, 0), nil
+	case "Q":
+		return t.AddDate(0, 3*v, 0), nil
+	case "Y":
+		return t.AddDate(v, 0, 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported time window unit: '%s'", w.Unit)
+	}
+}
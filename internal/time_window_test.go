@@ -0,0 +1,145 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OpenSLO/go-sdk/internal"
+	"github.com/OpenSLO/go-sdk/internal/assert"
+)
+
+func TestTimeWindow_Bounds(t *testing.T) {
+	warsaw, err := time.LoadLocation("Europe/Warsaw")
+	assert.Require(t, assert.NoError(t, err))
+
+	tests := map[string]struct {
+		window internal.TimeWindow
+		at     time.Time
+		start  time.Time
+		end    time.Time
+	}{
+		"rolling hours": {
+			window: internal.TimeWindow{Unit: "h", Value: 2, IsRolling: true},
+			at:     time.Date(2024, 3, 10, 1, 30, 0, 0, time.UTC),
+			start:  time.Date(2024, 3, 9, 23, 30, 0, 0, time.UTC),
+			end:    time.Date(2024, 3, 10, 1, 30, 0, 0, time.UTC),
+		},
+		"rolling month uses actual month length": {
+			window: internal.TimeWindow{Unit: "M", Value: 1, IsRolling: true},
+			at:     time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC),
+			start:  time.Date(2024, 2, 15, 12, 0, 0, 0, time.UTC),
+			end:    time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC),
+		},
+		"calendar month in leap year": {
+			window: internal.TimeWindow{
+				Unit:      "M",
+				Value:     1,
+				StartTime: "2022-01-01 00:00:00",
+				TimeZone:  "UTC",
+			},
+			at:    time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC),
+			start: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"calendar quarter in time zone": {
+			window: internal.TimeWindow{
+				Unit:      "Q",
+				Value:     1,
+				StartTime: "2023-01-01 00:00:00",
+				TimeZone:  "Europe/Warsaw",
+			},
+			// 2024-06-30 23:30 UTC is already July 1 in Warsaw.
+			at:    time.Date(2024, 6, 30, 23, 30, 0, 0, time.UTC),
+			start: time.Date(2024, 7, 1, 0, 0, 0, 0, warsaw),
+			end:   time.Date(2024, 10, 1, 0, 0, 0, 0, warsaw),
+		},
+		"calendar year": {
+			window: internal.TimeWindow{
+				Unit:      "Y",
+				Value:     1,
+				StartTime: "2020-04-01 00:00:00",
+				TimeZone:  "UTC",
+			},
+			at:    time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+			start: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"calendar week across daylight saving time change": {
+			window: internal.TimeWindow{
+				Unit:      "w",
+				Value:     1,
+				StartTime: "2024-03-25 00:00:00",
+				TimeZone:  "Europe/Warsaw",
+			},
+			at:    time.Date(2024, 3, 20, 12, 0, 0, 0, warsaw),
+			start: time.Date(2024, 3, 18, 0, 0, 0, 0, warsaw),
+			end:   time.Date(2024, 3, 25, 0, 0, 0, 0, warsaw),
+		},
+		"calendar at start time": {
+			window: internal.TimeWindow{
+				Unit:      "d",
+				Value:     7,
+				StartTime: "2024-01-01 00:00:00",
+				TimeZone:  "UTC",
+			},
+			at:    time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			start: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		"calendar minutes": {
+			window: internal.TimeWindow{
+				Unit:      "m",
+				Value:     15,
+				StartTime: "2024-01-01 00:05:00",
+				TimeZone:  "UTC",
+			},
+			at:    time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+			start: time.Date(2024, 5, 1, 9, 50, 0, 0, time.UTC),
+			end:   time.Date(2024, 5, 1, 10, 5, 0, 0, time.UTC),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			start, end, err := test.window.Bounds(test.at)
+			assert.Require(t, assert.NoError(t, err))
+			assert.True(t, test.start.Equal(start))
+			assert.True(t, test.end.Equal(end))
+		})
+	}
+}
+
+func TestTimeWindow_Bounds_Errors(t *testing.T) {
+	tests := map[string]struct {
+		window internal.TimeWindow
+		err    string
+	}{
+		"zero duration": {
+			window: internal.TimeWindow{Unit: "d", IsRolling: true},
+			err:    "time window duration must be positive, got: 0d",
+		},
+		"unsupported unit": {
+			window: internal.TimeWindow{Unit: "s", Value: 1, IsRolling: true},
+			err:    "unsupported time window unit: 's'",
+		},
+		"missing calendar": {
+			window: internal.TimeWindow{Unit: "d", Value: 1},
+			err:    "calendar start time must be set for calendar-aligned time window",
+		},
+		"invalid time zone": {
+			window: internal.TimeWindow{Unit: "d", Value: 1, StartTime: "2024-01-01 00:00:00", TimeZone: "Mars/Base"},
+			err:    "invalid calendar time zone: unknown time zone Mars/Base",
+		},
+		"invalid start time": {
+			window: internal.TimeWindow{Unit: "d", Value: 1, StartTime: "2024-01-01", TimeZone: "UTC"},
+			err: `invalid calendar start time: parsing time "2024-01-01" as "2006-01-02 15:04:05":` +
+				` cannot parse "" as "15"`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := test.window.Bounds(time.Now())
+			assert.Require(t, assert.Error(t, err))
+			assert.Equal(t, test.err, err.Error())
+		})
+	}
+}
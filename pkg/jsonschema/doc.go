@@ -0,0 +1,4 @@
+// Package jsonschema generates JSON Schema documents for OpenSLO objects.
+// The schemas are derived from the Go types and the validation rules defined for every [openslo.Object],
+// which guarantees they do not drift from the behavior of the SDK.
+package jsonschema
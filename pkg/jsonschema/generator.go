@@ -0,0 +1,471 @@
+package jsonschema
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nobl9/govy/pkg/govy"
+	"github.com/nobl9/govy/pkg/rules"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v1alpha"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+// Generate generates the JSON Schema for the [openslo.Object] of the provided [openslo.Version] and [openslo.Kind].
+//
+// The structure of the schema is derived from the Go type of the object,
+// unknown properties are not allowed, as they are rejected when decoding.
+// Validation rules are translated from the object's [govy.Validator] plan,
+// this includes required properties, allowed values, regular expressions, lengths and numeric limits.
+// Rules which cannot be expressed in the schema, like mutually exclusive properties,
+// are listed in the property's description.
+// Rules which apply only under specific conditions (see [govy.WhenDescription]) are omitted,
+// the schema is therefore never stricter than [openslo.Object.Validate].
+// Every condition must be described, otherwise an error is returned.
+func Generate(version openslo.Version, kind openslo.Kind) (*Schema, error) {
+	var generateFunc func() (*Schema, error)
+	switch version {
+	case openslo.VersionV1alpha:
+		generateFunc = getV1alphaGenerateFunc(kind)
+	case openslo.VersionV1:
+		generateFunc = getV1GenerateFunc(kind)
+	case openslo.VersionV2alpha:
+		generateFunc = getV2alphaGenerateFunc(kind)
+	default:
+		return nil, fmt.Errorf("unsupported %[1]T: %[1]s", version)
+	}
+	if generateFunc == nil {
+		return nil, fmt.Errorf("unsupported %[1]T: %[1]s for version: %[2]s", kind, version)
+	}
+	schema, err := generateFunc()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate JSON Schema for %s %s: %w", version, kind, err)
+	}
+	schema.Schema = Dialect
+	schema.Title = fmt.Sprintf("%s %s", version, kind)
+	return schema, nil
+}
+
+func getV1alphaGenerateFunc(kind openslo.Kind) func() (*Schema, error) {
+	switch kind {
+	case openslo.KindService:
+		return generate[v1alpha.Service]
+	case openslo.KindSLO:
+		return generate[v1alpha.SLO]
+	default:
+		return nil
+	}
+}
+
+func getV1GenerateFunc(kind openslo.Kind) func() (*Schema, error) {
+	switch kind {
+	case openslo.KindService:
+		return generate[v1.Service]
+	case openslo.KindSLO:
+		return generate[v1.SLO]
+	case openslo.KindSLI:
+		return generate[v1.SLI]
+	case openslo.KindDataSource:
+		return generate[v1.DataSource]
+	case openslo.KindAlertPolicy:
+		return generate[v1.AlertPolicy]
+	case openslo.KindAlertCondition:
+		return generate[v1.AlertCondition]
+	case openslo.KindAlertNotificationTarget:
+		return generate[v1.AlertNotificationTarget]
+	default:
+		return nil
+	}
+}
+
+func getV2alphaGenerateFunc(kind openslo.Kind) func() (*Schema, error) {
+	switch kind {
+	case openslo.KindService:
+		return generate[v2alpha.Service]
+	case openslo.KindSLO:
+		return generate[v2alpha.SLO]
+	case openslo.KindSLI:
+		return generate[v2alpha.SLI]
+	case openslo.KindDataSource:
+		return generate[v2alpha.DataSource]
+	case openslo.KindAlertPolicy:
+		return generate[v2alpha.AlertPolicy]
+	case openslo.KindAlertCondition:
+		return generate[v2alpha.AlertCondition]
+	case openslo.KindAlertNotificationTarget:
+		return generate[v2alpha.AlertNotificationTarget]
+	default:
+		return nil
+	}
+}
+
+func generate[T interface {
+	openslo.Object
+	GetValidator() govy.Validator[T]
+}]() (*Schema, error) {
+	var object T
+	// Strict mode guarantees that every condition is described,
+	// otherwise conditional rules would be indistinguishable from the unconditional ones.
+	plan, err := govy.Plan(object.GetValidator(), govy.PlanStrictMode())
+	if err != nil {
+		return nil, err
+	}
+	g := generator{
+		plans:    make(map[string]*govy.PropertyPlan, len(plan.Properties)),
+		visiting: make(map[reflect.Type]bool),
+	}
+	for _, property := range plan.Properties {
+		g.plans[property.Path.String()] = property
+	}
+	return g.schemaForType(reflect.TypeOf(object), "$")
+}
+
+type generator struct {
+	// plans maps JSON paths to the properties' validation plans.
+	plans map[string]*govy.PropertyPlan
+	// visiting is used to detect recursive types.
+	visiting map[reflect.Type]bool
+}
+
+var (
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	jsonMarshalerType   = reflect.TypeFor[json.Marshaler]()
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+)
+
+// customTypeSchemas defines schemas for types with custom JSON encoding.
+var customTypeSchemas = map[reflect.Type]func(g generator, path string) *Schema{
+	reflect.TypeFor[v1.DurationShorthand]():      durationShorthandSchema,
+	reflect.TypeFor[v2alpha.DurationShorthand](): durationShorthandSchema,
+	reflect.TypeFor[v1.Label](): func(generator, string) *Schema {
+		// Label can be either a single string or a list of strings.
+		return &Schema{AnyOf: []*Schema{
+			{Type: "string"},
+			{Type: "array", Items: &Schema{Type: "string"}},
+		}}
+	},
+}
+
+func (g generator) schemaForType(typ reflect.Type, path string) (*Schema, error) {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	schema, err := g.schemaForTypeStructure(typ, path)
+	if err != nil {
+		return nil, err
+	}
+	if plan, ok := g.plans[path]; ok {
+		if err = applyPropertyPlan(schema, plan); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return schema, nil
+}
+
+func (g generator) schemaForTypeStructure(typ reflect.Type, path string) (*Schema, error) {
+	if schemaFunc, ok := customTypeSchemas[typ]; ok {
+		return schemaFunc(g, path), nil
+	}
+	switch {
+	case implements(typ, jsonMarshalerType) || implements(typ, jsonUnmarshalerType):
+		// Custom JSON encoding, e.g. json.RawMessage, can be any JSON value.
+		return &Schema{}, nil
+	case typ.Kind() != reflect.String &&
+		(implements(typ, textMarshalerType) || implements(typ, textUnmarshalerType)):
+		return &Schema{Type: "string"}, nil
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := g.schemaForType(typ.Elem(), path+"[*]")
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		return g.schemaForMap(typ, path)
+	case reflect.Struct:
+		return g.schemaForStruct(typ, path)
+	default:
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+}
+
+func (g generator) schemaForMap(typ reflect.Type, path string) (*Schema, error) {
+	values, err := g.schemaForType(typ.Elem(), path+".*")
+	if err != nil {
+		return nil, err
+	}
+	schema := &Schema{Type: "object", AdditionalProperties: values}
+	if plan, ok := g.plans[path+".*~"]; ok {
+		keys := &Schema{}
+		if err = applyPropertyPlan(keys, plan); err != nil {
+			return nil, fmt.Errorf("%s: %w", plan.Path, err)
+		}
+		if !reflect.DeepEqual(keys, &Schema{}) {
+			schema.PropertyNames = keys
+		}
+	}
+	return schema, nil
+}
+
+func (g generator) schemaForStruct(typ reflect.Type, path string) (*Schema, error) {
+	if g.visiting[typ] {
+		return &Schema{}, nil
+	}
+	g.visiting[typ] = true
+	defer delete(g.visiting, typ)
+
+	schema := &Schema{
+		Type:                 "object",
+		Properties:           make(map[string]*Schema),
+		AdditionalProperties: false,
+	}
+	if err := g.addStructFields(schema, typ, path, false); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// addStructFields adds the struct fields to the object schema.
+// Fields of embedded structs are inlined, if the struct is embedded through a pointer,
+// its fields are never required, as the whole struct is optional.
+func (g generator) addStructFields(schema *Schema, typ reflect.Type, path string, optional bool) error {
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		name, ok := getJSONFieldName(field)
+		if !ok {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				isPointer := field.Type.Kind() == reflect.Pointer
+				if err := g.addStructFields(schema, embedded, path, optional || isPointer); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fieldPath := path + "." + name
+		property, err := g.schemaForType(field.Type, fieldPath)
+		if err != nil {
+			return err
+		}
+		schema.Properties[name] = property
+		if !optional && g.isRequired(fieldPath) {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return nil
+}
+
+func (g generator) isRequired(path string) bool {
+	plan, ok := g.plans[path]
+	if !ok {
+		return false
+	}
+	return slices.ContainsFunc(plan.Rules, func(rule govy.RulePlan) bool {
+		return len(rule.Conditions) == 0 && rule.ErrorCode == rules.ErrorCodeRequired
+	})
+}
+
+// durationShorthandSchema returns the schema for the duration shorthand, e.g. '1w'.
+// If the allowed units are known, the pattern lists them explicitly.
+func durationShorthandSchema(g generator, path string) *Schema {
+	units := "[a-zA-Z]"
+	if plan, ok := g.plans[path+".unit"]; ok {
+		if values := getAllowedValues(plan); len(values) > 0 {
+			units = "(" + strings.Join(values, "|") + ")"
+		}
+	}
+	return &Schema{Type: "string", Pattern: "^[0-9]+" + units + "$"}
+}
+
+func getJSONFieldName(field reflect.StructField) (name string, ok bool) {
+	if !field.IsExported() && !field.Anonymous {
+		return "", false
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name, _, _ = strings.Cut(tag, ",")
+	return name, true
+}
+
+func implements(typ, iface reflect.Type) bool {
+	return typ.Implements(iface) || reflect.PointerTo(typ).Implements(iface)
+}
+
+// applyPropertyPlan translates unconditional rules of the property into the schema keywords.
+func applyPropertyPlan(schema *Schema, plan *govy.PropertyPlan) error {
+	if values := getAllowedValues(plan); len(values) == 1 {
+		schema.Const = values[0]
+	} else {
+		for _, value := range values {
+			schema.Enum = append(schema.Enum, value)
+		}
+	}
+	var descriptions []string
+	for _, rule := range plan.Rules {
+		if len(rule.Conditions) > 0 {
+			continue
+		}
+		handled, err := applyRulePlan(schema, rule)
+		if err != nil {
+			return fmt.Errorf("failed to translate '%s' rule: %w", rule.ErrorCode, err)
+		}
+		if !handled && rule.Description != "" && !slices.Contains(descriptions, rule.Description) {
+			descriptions = append(descriptions, rule.Description)
+		}
+	}
+	if len(descriptions) > 0 {
+		schema.Description = strings.Join(descriptions, "; ")
+	}
+	return nil
+}
+
+// getAllowedValues returns the values allowed for the property,
+// if any rule restricting them is conditional, nil is returned.
+func getAllowedValues(plan *govy.PropertyPlan) []string {
+	if len(plan.Values) == 0 {
+		return nil
+	}
+	for _, rule := range plan.Rules {
+		switch getErrorCode(rule) {
+		case rules.ErrorCodeOneOf, rules.ErrorCodeEqualTo:
+			if len(rule.Conditions) > 0 {
+				return nil
+			}
+		}
+	}
+	return plan.Values
+}
+
+const (
+	regexpDescriptionPrefix = "string must match regular expression: "
+	// errorCodeOptional is assigned by govy to properties marked with [govy.PropertyRules.OmitEmpty].
+	errorCodeOptional govy.ErrorCode = "optional"
+)
+
+// applyRulePlan translates a single rule into the schema keywords.
+// It returns false if the rule cannot be expressed in the schema.
+func applyRulePlan(schema *Schema, rule govy.RulePlan) (bool, error) {
+	if pattern, ok := strings.CutPrefix(rule.Description, regexpDescriptionPrefix); ok {
+		schema.addPattern(strings.TrimPrefix(strings.TrimSuffix(pattern, "'"), "'"))
+		return true, nil
+	}
+	var err error
+	switch getErrorCode(rule) {
+	case rules.ErrorCodeRequired:
+		if schema.Type == "string" && schema.MinLength == nil {
+			schema.MinLength = ptr(1)
+		}
+	case errorCodeOptional, rules.ErrorCodeOneOf, rules.ErrorCodeEqualTo:
+		// Handled separately.
+	case rules.ErrorCodeStringNotEmpty:
+		schema.MinLength = ptr(1)
+	case rules.ErrorCodeStringLength:
+		schema.MinLength, schema.MaxLength, err = parseLengthRange(rule.Description)
+	case rules.ErrorCodeStringMinLength:
+		schema.MinLength, err = parseLength(rule.Description, "length must be greater than or equal to %d")
+	case rules.ErrorCodeStringMaxLength:
+		schema.MaxLength, err = parseLength(rule.Description, "length must be less than or equal to %d")
+	case rules.ErrorCodeSliceLength:
+		schema.MinItems, schema.MaxItems, err = parseLengthRange(rule.Description)
+	case rules.ErrorCodeSliceMinLength:
+		schema.MinItems, err = parseLength(rule.Description, "length must be greater than or equal to %d")
+	case rules.ErrorCodeSliceMaxLength:
+		schema.MaxItems, err = parseLength(rule.Description, "length must be less than or equal to %d")
+	case rules.ErrorCodeMapLength:
+		schema.MinProperties, schema.MaxProperties, err = parseLengthRange(rule.Description)
+	case rules.ErrorCodeMapMinLength:
+		schema.MinProperties, err = parseLength(rule.Description, "length must be greater than or equal to %d")
+	case rules.ErrorCodeMapMaxLength:
+		schema.MaxProperties, err = parseLength(rule.Description, "length must be less than or equal to %d")
+	case rules.ErrorCodeGreaterThan:
+		schema.ExclusiveMinimum, err = parseLimit(rule.Description, "must be greater than ")
+	case rules.ErrorCodeGreaterThanOrEqualTo:
+		schema.Minimum, err = parseLimit(rule.Description, "must be greater than or equal to ")
+	case rules.ErrorCodeLessThan:
+		schema.ExclusiveMaximum, err = parseLimit(rule.Description, "must be less than ")
+	case rules.ErrorCodeLessThanOrEqualTo:
+		schema.Maximum, err = parseLimit(rule.Description, "must be less than or equal to ")
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+// getErrorCode returns the most specific error code of the rule,
+// e.g. 'string_length' for 'string_dns_label:string_length'.
+func getErrorCode(rule govy.RulePlan) govy.ErrorCode {
+	code := string(rule.ErrorCode)
+	if i := strings.LastIndex(code, ":"); i != -1 {
+		code = code[i+1:]
+	}
+	return govy.ErrorCode(code)
+}
+
+func (s *Schema) addPattern(pattern string) {
+	if s.Pattern == "" {
+		s.Pattern = pattern
+		return
+	}
+	s.AllOf = append(s.AllOf, &Schema{Pattern: pattern})
+}
+
+func parseLengthRange(description string) (minLength, maxLength *int, err error) {
+	var lower, upper int
+	if _, err = fmt.Sscanf(description, "length must be between %d and %d", &lower, &upper); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse length range from '%s': %w", description, err)
+	}
+	return &lower, &upper, nil
+}
+
+func parseLength(description, format string) (*int, error) {
+	var length int
+	if _, err := fmt.Sscanf(description, format, &length); err != nil {
+		return nil, fmt.Errorf("failed to parse length from '%s': %w", description, err)
+	}
+	return &length, nil
+}
+
+func parseLimit(description, prefix string) (*float64, error) {
+	value, ok := strings.CutPrefix(description, prefix)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse limit from '%s'", description)
+	}
+	limit, err := strconv.ParseFloat(strings.Trim(value, "'"), 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse limit from '%s': %w", description, err)
+	}
+	return &limit, nil
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -0,0 +1,54 @@
+package jsonschema
+
+import (
+	"embed"
+	"encoding/json"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v1alpha"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+//go:embed test_data
+var testData embed.FS
+
+func TestGenerate(t *testing.T) {
+	supportedKinds := map[openslo.Version][]openslo.Kind{
+		openslo.VersionV1alpha: v1alpha.GetSupportedKinds(),
+		openslo.VersionV1:      v1.GetSupportedKinds(),
+		openslo.VersionV2alpha: v2alpha.GetSupportedKinds(),
+	}
+	for version, kinds := range supportedKinds {
+		for _, kind := range kinds {
+			testDataFile := filepath.Join("test_data", path.Base(version.String()), kind.String()+".json")
+			t.Run(testDataFile, func(t *testing.T) {
+				schema, err := Generate(version, kind)
+				assert.Require(t, assert.NoError(t, err))
+				data, err := json.MarshalIndent(schema, "", "  ")
+				assert.Require(t, assert.NoError(t, err))
+
+				expected, err := testData.ReadFile(testDataFile)
+				assert.Require(t, assert.NoError(t, err))
+				assert.Equal(t, string(expected), string(data)+"\n")
+			})
+		}
+	}
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	t.Run("unsupported version", func(t *testing.T) {
+		_, err := Generate("openslo/v0", openslo.KindSLO)
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "unsupported openslo.Version: openslo/v0", err.Error())
+	})
+	t.Run("unsupported kind", func(t *testing.T) {
+		_, err := Generate(openslo.VersionV1alpha, openslo.KindSLI)
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "unsupported openslo.Kind: SLI for version: openslo/v1alpha", err.Error())
+	})
+}
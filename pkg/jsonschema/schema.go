@@ -0,0 +1,39 @@
+package jsonschema
+
+// Dialect is the JSON Schema dialect of the generated schemas.
+const Dialect = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema document.
+// Only the keywords used by [Generate] are supported.
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Const       any    `json:"const,omitempty"`
+	Enum        []any  `json:"enum,omitempty"`
+	// String keywords.
+	Pattern   string `json:"pattern,omitempty"`
+	MinLength *int   `json:"minLength,omitempty"`
+	MaxLength *int   `json:"maxLength,omitempty"`
+	// Number keywords.
+	Minimum          *float64 `json:"minimum,omitempty"`
+	ExclusiveMinimum *float64 `json:"exclusiveMinimum,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty"`
+	ExclusiveMaximum *float64 `json:"exclusiveMaximum,omitempty"`
+	// Array keywords.
+	Items    *Schema `json:"items,omitempty"`
+	MinItems *int    `json:"minItems,omitempty"`
+	MaxItems *int    `json:"maxItems,omitempty"`
+	// Object keywords.
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	// AdditionalProperties is either a *Schema or a bool.
+	AdditionalProperties any     `json:"additionalProperties,omitempty"`
+	PropertyNames        *Schema `json:"propertyNames,omitempty"`
+	MinProperties        *int    `json:"minProperties,omitempty"`
+	MaxProperties        *int    `json:"maxProperties,omitempty"`
+	// Composition keywords.
+	AllOf []*Schema `json:"allOf,omitempty"`
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+}
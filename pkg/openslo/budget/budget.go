@@ -0,0 +1,164 @@
+package budget
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoData is returned by [Objective.Calculate] when there are no events to compute the SLI from.
+var ErrNoData = errors.New("no data to calculate the SLI from")
+
+// Counts holds the number of good and total events.
+// Use [GoodTotal], [BadTotal], [RawSuccessRatio] or [RawFailureRatio] to create it,
+// depending on the SLI metric type.
+type Counts struct {
+	Good  float64
+	Total float64
+}
+
+// GoodTotal creates [Counts] for the good and total ratio metric.
+func GoodTotal(good, total float64) Counts {
+	return Counts{Good: good, Total: total}
+}
+
+// BadTotal creates [Counts] for the bad and total ratio metric.
+func BadTotal(bad, total float64) Counts {
+	return Counts{Good: total - bad, Total: total}
+}
+
+// RawSuccessRatio creates [Counts] for the raw ratio metric of the 'success' type.
+func RawSuccessRatio(ratio float64) Counts {
+	return Counts{Good: ratio, Total: 1}
+}
+
+// RawFailureRatio creates [Counts] for the raw ratio metric of the 'failure' type.
+func RawFailureRatio(ratio float64) Counts {
+	return Counts{Good: 1 - ratio, Total: 1}
+}
+
+func (c Counts) validate() error {
+	if c.Good < 0 || c.Total < 0 {
+		return fmt.Errorf("counts must not be negative, got good: %g, total: %g", c.Good, c.Total)
+	}
+	if c.Good > c.Total {
+		return fmt.Errorf("good count must not exceed total count, got good: %g, total: %g", c.Good, c.Total)
+	}
+	return nil
+}
+
+// Result is the outcome of [Objective.Calculate].
+// All the values are ratios, for instance 0.25 of consumed error budget means 25% of it was used.
+type Result struct {
+	// SLI is the computed SLI value, in the range [0, 1].
+	SLI float64
+	// BudgetConsumed is the ratio of the error budget consumed by the provided [Counts].
+	// It exceeds 1 once the error budget is exhausted.
+	BudgetConsumed float64
+	// BudgetRemaining is the ratio of the error budget left, it's negative once the error budget is exhausted.
+	BudgetRemaining float64
+	// BurnRate is the rate at which the error budget is consumed.
+	// Burn rate of 1 means the error budget will be exactly exhausted at the end of the SLO time window.
+	BurnRate float64
+}
+
+// Calculate computes [Result] for the provided [Counts].
+// The [Counts] are interpreted according to the [Objective.Method]:
+//   - [MethodOccurrences] sums all the [Counts]
+//   - [MethodTimeslices] and [MethodRatioTimeslices] treat each [Counts] as a single time slice
+//
+// In order to compute the burn rate over a specific lookback window,
+// provide only the [Counts] observed within that window.
+func (o Objective) Calculate(counts ...Counts) (Result, error) {
+	if err := o.Validate(); err != nil {
+		return Result{}, err
+	}
+	for i := range counts {
+		if err := counts[i].validate(); err != nil {
+			return Result{}, fmt.Errorf("invalid counts at index %d: %w", i, err)
+		}
+	}
+	switch o.Method {
+	case MethodTimeslices:
+		return o.calculateTimeslices(counts)
+	case MethodRatioTimeslices:
+		return o.calculateRatioTimeslices(counts)
+	default:
+		return o.calculateOccurrences(counts)
+	}
+}
+
+func (o Objective) calculateOccurrences(counts []Counts) (Result, error) {
+	var good, total float64
+	for _, c := range counts {
+		good += c.Good
+		total += c.Total
+	}
+	if total == 0 {
+		return Result{}, ErrNoData
+	}
+	sli := good / total
+	return o.newResult(sli, 1-sli), nil
+}
+
+func (o Objective) calculateTimeslices(counts []Counts) (Result, error) {
+	var goodSlices, totalSlices, badSlices float64
+	for _, c := range counts {
+		if c.Total == 0 {
+			continue
+		}
+		totalSlices++
+		if c.Good/c.Total >= o.TimeSliceTarget {
+			goodSlices++
+		} else {
+			badSlices++
+		}
+	}
+	if totalSlices == 0 {
+		return Result{}, ErrNoData
+	}
+	return o.newTimeslicesResult(goodSlices/totalSlices, badSlices), nil
+}
+
+func (o Objective) calculateRatioTimeslices(counts []Counts) (Result, error) {
+	var ratios, totalSlices float64
+	for _, c := range counts {
+		if c.Total == 0 {
+			continue
+		}
+		totalSlices++
+		ratios += c.Good / c.Total
+	}
+	if totalSlices == 0 {
+		return Result{}, ErrNoData
+	}
+	return o.newTimeslicesResult(ratios/totalSlices, totalSlices-ratios), nil
+}
+
+// newTimeslicesResult creates [Result] for time slices based budgeting methods.
+// If the number of time slices in the SLO time window is known,
+// the consumed error budget is computed relative to the whole window instead of the observed time slices.
+func (o Objective) newTimeslicesResult(sli, badSlices float64) Result {
+	result := o.newResult(sli, 1-sli)
+	if o.TimeSlicesInWindow == 0 {
+		return result
+	}
+	consumed := o.budgetRatio(badSlices / float64(o.TimeSlicesInWindow))
+	result.BudgetConsumed = consumed
+	result.BudgetRemaining = 1 - consumed
+	return result
+}
+
+func (o Objective) newResult(sli, errorRate float64) Result {
+	burnRate := o.budgetRatio(errorRate)
+	return Result{
+		SLI:             sli,
+		BudgetConsumed:  burnRate,
+		BudgetRemaining: 1 - burnRate,
+		BurnRate:        burnRate,
+	}
+}
+
+// budgetRatio returns the ratio of the provided error rate to the error budget.
+func (o Objective) budgetRatio(errorRate float64) float64 {
+	return errorRate / (1 - o.Target)
+}
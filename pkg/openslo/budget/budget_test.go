@@ -0,0 +1,130 @@
+package budget
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+)
+
+func TestObjective_Calculate(t *testing.T) {
+	tests := map[string]struct {
+		objective Objective
+		counts    []Counts
+		expected  Result
+	}{
+		"occurrences, good and total": {
+			objective: Objective{Method: MethodOccurrences, Target: 0.75},
+			counts:    []Counts{GoodTotal(80, 100), GoodTotal(95, 100)},
+			expected: Result{
+				SLI:             0.875,
+				BudgetConsumed:  0.5,
+				BudgetRemaining: 0.5,
+				BurnRate:        0.5,
+			},
+		},
+		"occurrences, bad and total": {
+			objective: Objective{Method: MethodOccurrences, Target: 0.75},
+			counts:    []Counts{BadTotal(50, 100)},
+			expected: Result{
+				SLI:             0.5,
+				BudgetConsumed:  2,
+				BudgetRemaining: -1,
+				BurnRate:        2,
+			},
+		},
+		"occurrences, raw failure ratio": {
+			objective: Objective{Method: MethodOccurrences, Target: 0.5},
+			counts:    []Counts{RawFailureRatio(0.25)},
+			expected: Result{
+				SLI:             0.75,
+				BudgetConsumed:  0.5,
+				BudgetRemaining: 0.5,
+				BurnRate:        0.5,
+			},
+		},
+		"timeslices, unknown window": {
+			objective: Objective{Method: MethodTimeslices, Target: 0.5, TimeSliceTarget: 0.9},
+			counts: []Counts{
+				GoodTotal(95, 100),
+				GoodTotal(80, 100),
+				GoodTotal(0, 0),
+				RawSuccessRatio(0.9),
+				GoodTotal(100, 100),
+			},
+			expected: Result{
+				SLI:             0.75,
+				BudgetConsumed:  0.5,
+				BudgetRemaining: 0.5,
+				BurnRate:        0.5,
+			},
+		},
+		"timeslices, known window": {
+			objective: Objective{
+				Method:             MethodTimeslices,
+				Target:             0.5,
+				TimeSliceTarget:    0.9,
+				TimeSlicesInWindow: 16,
+			},
+			counts: []Counts{
+				GoodTotal(95, 100),
+				GoodTotal(80, 100),
+				RawSuccessRatio(0.9),
+				GoodTotal(100, 100),
+			},
+			expected: Result{
+				SLI:             0.75,
+				BudgetConsumed:  0.125,
+				BudgetRemaining: 0.875,
+				BurnRate:        0.5,
+			},
+		},
+		"ratio timeslices, known window": {
+			objective: Objective{
+				Method:             MethodRatioTimeslices,
+				Target:             0.75,
+				TimeSlicesInWindow: 8,
+			},
+			counts: []Counts{
+				GoodTotal(50, 100),
+				RawSuccessRatio(1),
+			},
+			expected: Result{
+				SLI:             0.75,
+				BudgetConsumed:  0.25,
+				BudgetRemaining: 0.75,
+				BurnRate:        1,
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := test.objective.Calculate(test.counts...)
+			assert.Require(t, assert.NoError(t, err))
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestObjective_Calculate_Errors(t *testing.T) {
+	t.Run("no data", func(t *testing.T) {
+		for _, method := range []Method{MethodOccurrences, MethodTimeslices, MethodRatioTimeslices} {
+			objective := Objective{Method: method, Target: 0.9}
+			_, err := objective.Calculate(GoodTotal(0, 0))
+			assert.True(t, errors.Is(err, ErrNoData))
+		}
+	})
+	t.Run("invalid counts", func(t *testing.T) {
+		objective := Objective{Method: MethodOccurrences, Target: 0.9}
+		_, err := objective.Calculate(GoodTotal(1, 1), GoodTotal(2, 1))
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "invalid counts at index 1: good count must not exceed total count, got good: 2, total: 1",
+			err.Error())
+	})
+	t.Run("invalid objective", func(t *testing.T) {
+		objective := Objective{Method: MethodOccurrences, Target: 1}
+		_, err := objective.Calculate(GoodTotal(1, 1))
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "target must be in the range [0, 1), got: 1", err.Error())
+	})
+}
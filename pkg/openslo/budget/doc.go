@@ -0,0 +1,3 @@
+// Package budget implements error budget and burn rate calculations for OpenSLO objectives.
+// It works with [v1.SLO] and [v2alpha.SLO] objectives, which are first converted into an [Objective].
+package budget
@@ -0,0 +1,148 @@
+package budget
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OpenSLO/go-sdk/internal"
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+// Method defines how the SLI is computed from the provided [Counts].
+// It mirrors the SLO budgeting methods defined by the OpenSLO specification.
+type Method string
+
+const (
+	// MethodOccurrences computes the SLI as a ratio of good to total events, summed across all [Counts].
+	MethodOccurrences Method = "Occurrences"
+	// MethodTimeslices treats each [Counts] as a single time slice.
+	// A time slice is good if its ratio of good to total events meets [Objective.TimeSliceTarget].
+	// The SLI is the ratio of good to total time slices.
+	MethodTimeslices Method = "Timeslices"
+	// MethodRatioTimeslices treats each [Counts] as a single time slice.
+	// The SLI is the average of the time slices' ratios of good to total events.
+	MethodRatioTimeslices Method = "RatioTimeslices"
+)
+
+// Objective is a version-agnostic representation of an SLO objective,
+// containing only the properties required to perform the calculations.
+type Objective struct {
+	// Target is the objective's target, a ratio in the range [0, 1).
+	Target float64
+	// Method is the budgeting method of the SLO.
+	Method Method
+	// TimeSliceTarget is the ratio a single time slice has to meet in order to be considered good.
+	// It's only used by [MethodTimeslices].
+	TimeSliceTarget float64
+	// TimeSlicesInWindow is the number of time slices in the whole SLO time window.
+	// It's only used by [MethodTimeslices] and [MethodRatioTimeslices] to compute the consumed error budget.
+	// If it's zero, the number of provided time slices is used instead.
+	TimeSlicesInWindow int
+}
+
+// NewV1Objective creates an [Objective] from the [v1.SLO] objective at the provided index.
+func NewV1Objective(slo v1.SLO, objectiveIndex int) (Objective, error) {
+	return newObjective(slo, slo.Spec.Objectives, objectiveIndex, func(objective v1.SLOObjective) objectiveFields {
+		fields := objectiveFields{
+			method:          Method(slo.Spec.BudgetingMethod),
+			target:          objective.Target,
+			targetPercent:   objective.TargetPercent,
+			timeSliceTarget: objective.TimeSliceTarget,
+		}
+		if len(slo.Spec.TimeWindow) > 0 {
+			fields.timeWindow = slo.Spec.TimeWindow[0].Duration.Duration()
+		}
+		if objective.TimeSliceWindow != nil {
+			fields.timeSliceWindow = objective.TimeSliceWindow.Duration()
+		}
+		return fields
+	})
+}
+
+// NewV2alphaObjective creates an [Objective] from the [v2alpha.SLO] objective at the provided index.
+func NewV2alphaObjective(slo v2alpha.SLO, objectiveIndex int) (Objective, error) {
+	return newObjective(slo, slo.Spec.Objectives, objectiveIndex, func(objective v2alpha.SLOObjective) objectiveFields {
+		fields := objectiveFields{
+			method:          Method(slo.Spec.BudgetingMethod),
+			target:          objective.Target,
+			targetPercent:   objective.TargetPercent,
+			timeSliceTarget: objective.TimeSliceTarget,
+		}
+		if len(slo.Spec.TimeWindow) > 0 {
+			fields.timeWindow = slo.Spec.TimeWindow[0].Duration.Duration()
+		}
+		if objective.TimeSliceWindow != nil {
+			fields.timeSliceWindow = objective.TimeSliceWindow.Duration()
+		}
+		return fields
+	})
+}
+
+// objectiveFields holds the version-agnostic fields of an SLO objective which are required to create an [Objective].
+type objectiveFields struct {
+	method                                 Method
+	target, targetPercent, timeSliceTarget *float64
+	timeWindow, timeSliceWindow            time.Duration
+}
+
+// newObjective creates an [Objective] from the SLO objective at the provided index,
+// the fields of the objective are extracted with getFields.
+func newObjective[T any](
+	slo openslo.Object,
+	objectives []T,
+	objectiveIndex int,
+	getFields func(T) objectiveFields,
+) (Objective, error) {
+	if objectiveIndex < 0 || objectiveIndex >= len(objectives) {
+		return Objective{}, fmt.Errorf("%s has no objective at index %d", slo, objectiveIndex)
+	}
+	o, err := newObjectiveFromFields(getFields(objectives[objectiveIndex]))
+	if err != nil {
+		return Objective{}, fmt.Errorf("invalid %s objective at index %d: %w", slo, objectiveIndex, err)
+	}
+	return o, nil
+}
+
+func newObjectiveFromFields(fields objectiveFields) (Objective, error) {
+	o := Objective{Method: fields.method}
+	switch {
+	case fields.target != nil:
+		o.Target = *fields.target
+	case fields.targetPercent != nil:
+		o.Target = internal.PercentToRatio(*fields.targetPercent)
+	default:
+		return Objective{}, errors.New("either 'target' or 'targetPercent' must be set")
+	}
+	if fields.method == MethodTimeslices {
+		if fields.timeSliceTarget == nil {
+			return Objective{}, fmt.Errorf("'timeSliceTarget' must be set for %s budgeting method", fields.method)
+		}
+		o.TimeSliceTarget = *fields.timeSliceTarget
+	}
+	if fields.timeWindow > 0 && fields.timeSliceWindow > 0 {
+		o.TimeSlicesInWindow = int(fields.timeWindow / fields.timeSliceWindow)
+	}
+	return o, o.Validate()
+}
+
+// Validate checks if the [Objective] can be used to perform calculations.
+func (o Objective) Validate() error {
+	switch o.Method {
+	case MethodOccurrences, MethodTimeslices, MethodRatioTimeslices:
+	default:
+		return fmt.Errorf("unsupported budgeting method: '%s'", o.Method)
+	}
+	if o.Target < 0 || o.Target >= 1 {
+		return fmt.Errorf("target must be in the range [0, 1), got: %g", o.Target)
+	}
+	if o.TimeSliceTarget < 0 || o.TimeSliceTarget > 1 {
+		return fmt.Errorf("time slice target must be in the range [0, 1], got: %g", o.TimeSliceTarget)
+	}
+	if o.TimeSlicesInWindow < 0 {
+		return fmt.Errorf("time slices in window must not be negative, got: %d", o.TimeSlicesInWindow)
+	}
+	return nil
+}
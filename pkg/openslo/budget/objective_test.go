@@ -0,0 +1,165 @@
+package budget
+
+import (
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+func TestNewV1Objective(t *testing.T) {
+	tests := map[string]struct {
+		spec     v1.SLOSpec
+		expected Objective
+		err      string
+	}{
+		"target": {
+			spec: v1.SLOSpec{
+				BudgetingMethod: v1.SLOBudgetingMethodOccurrences,
+				Objectives:      []v1.SLOObjective{{Target: ptr(0.99)}},
+			},
+			expected: Objective{Method: MethodOccurrences, Target: 0.99},
+		},
+		"target percent": {
+			spec: v1.SLOSpec{
+				BudgetingMethod: v1.SLOBudgetingMethodOccurrences,
+				Objectives:      []v1.SLOObjective{{TargetPercent: ptr(75.0)}},
+			},
+			expected: Objective{Method: MethodOccurrences, Target: 0.75},
+		},
+		"fractional target percent": {
+			spec: v1.SLOSpec{
+				BudgetingMethod: v1.SLOBudgetingMethodOccurrences,
+				Objectives:      []v1.SLOObjective{{TargetPercent: ptr(99.9)}},
+			},
+			expected: Objective{Method: MethodOccurrences, Target: 0.999},
+		},
+		"timeslices": {
+			spec: v1.SLOSpec{
+				BudgetingMethod: v1.SLOBudgetingMethodTimeslices,
+				TimeWindow: []v1.SLOTimeWindow{{
+					Duration:  v1.NewDurationShorthand(1, v1.DurationShorthandUnitDay),
+					IsRolling: true,
+				}},
+				Objectives: []v1.SLOObjective{{
+					Target:          ptr(0.9),
+					TimeSliceTarget: ptr(0.95),
+					TimeSliceWindow: ptr(v1.NewDurationShorthand(5, v1.DurationShorthandUnitMinute)),
+				}},
+			},
+			expected: Objective{
+				Method:             MethodTimeslices,
+				Target:             0.9,
+				TimeSliceTarget:    0.95,
+				TimeSlicesInWindow: 288,
+			},
+		},
+		"missing target": {
+			spec: v1.SLOSpec{
+				BudgetingMethod: v1.SLOBudgetingMethodOccurrences,
+				Objectives:      []v1.SLOObjective{{}},
+			},
+			err: "invalid v1.SLO 'my-slo' objective at index 0: either 'target' or 'targetPercent' must be set",
+		},
+		"missing time slice target": {
+			spec: v1.SLOSpec{
+				BudgetingMethod: v1.SLOBudgetingMethodTimeslices,
+				Objectives:      []v1.SLOObjective{{Target: ptr(0.9)}},
+			},
+			err: "invalid v1.SLO 'my-slo' objective at index 0:" +
+				" 'timeSliceTarget' must be set for Timeslices budgeting method",
+		},
+		"missing objective": {
+			spec: v1.SLOSpec{BudgetingMethod: v1.SLOBudgetingMethodOccurrences},
+			err:  "v1.SLO 'my-slo' has no objective at index 0",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			slo := v1.NewSLO(v1.Metadata{Name: "my-slo"}, test.spec)
+			objective, err := NewV1Objective(slo, 0)
+			if test.err != "" {
+				assert.Require(t, assert.Error(t, err))
+				assert.Equal(t, test.err, err.Error())
+				return
+			}
+			assert.Require(t, assert.NoError(t, err))
+			assert.Equal(t, test.expected, objective)
+		})
+	}
+}
+
+func TestNewV2alphaObjective(t *testing.T) {
+	slo := v2alpha.NewSLO(v2alpha.Metadata{Name: "my-slo"}, v2alpha.SLOSpec{
+		BudgetingMethod: v2alpha.SLOBudgetingMethodRatioTimeslices,
+		TimeWindow: []v2alpha.SLOTimeWindow{{
+			Duration:  v2alpha.NewDurationShorthand(1, v2alpha.DurationShorthandUnitWeek),
+			IsRolling: true,
+		}},
+		Objectives: []v2alpha.SLOObjective{
+			{Target: ptr(0.99)},
+			{
+				TargetPercent:   ptr(50.0),
+				TimeSliceWindow: ptr(v2alpha.NewDurationShorthand(1, v2alpha.DurationShorthandUnitHour)),
+			},
+		},
+	})
+
+	objective, err := NewV2alphaObjective(slo, 1)
+
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, Objective{
+		Method:             MethodRatioTimeslices,
+		Target:             0.5,
+		TimeSlicesInWindow: 168,
+	}, objective)
+
+	_, err = NewV2alphaObjective(slo, 2)
+	assert.Require(t, assert.Error(t, err))
+	assert.Equal(t, "v2alpha.SLO 'my-slo' has no objective at index 2", err.Error())
+}
+
+func TestObjective_Validate(t *testing.T) {
+	tests := map[string]struct {
+		objective Objective
+		err       string
+	}{
+		"valid": {
+			objective: Objective{Method: MethodOccurrences, Target: 0.99},
+		},
+		"unsupported method": {
+			objective: Objective{Method: "Events", Target: 0.99},
+			err:       "unsupported budgeting method: 'Events'",
+		},
+		"target too high": {
+			objective: Objective{Method: MethodOccurrences, Target: 1},
+			err:       "target must be in the range [0, 1), got: 1",
+		},
+		"negative target": {
+			objective: Objective{Method: MethodOccurrences, Target: -0.5},
+			err:       "target must be in the range [0, 1), got: -0.5",
+		},
+		"time slice target too high": {
+			objective: Objective{Method: MethodTimeslices, Target: 0.9, TimeSliceTarget: 1.5},
+			err:       "time slice target must be in the range [0, 1], got: 1.5",
+		},
+		"negative time slices in window": {
+			objective: Objective{Method: MethodRatioTimeslices, Target: 0.9, TimeSlicesInWindow: -1},
+			err:       "time slices in window must not be negative, got: -1",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.objective.Validate()
+			if test.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Require(t, assert.Error(t, err))
+			assert.Equal(t, test.err, err.Error())
+		})
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
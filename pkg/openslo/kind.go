@@ -6,6 +6,7 @@ import (
 
 // Kind represents all the object kinds defined by OpenSLO specification.
 // Keep in mind not all specification versions support every [Kind].
+// Custom kinds can be added with [RegisterObject].
 type Kind string
 
 const (
@@ -41,6 +42,9 @@ func (k Kind) Validate() error {
 		KindAlertNotificationTarget:
 		return nil
 	default:
+		if isKindRegistered(k) {
+			return nil
+		}
 		return fmt.Errorf("unsupported %[1]T: %[1]s", k)
 	}
 }
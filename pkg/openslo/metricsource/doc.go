@@ -0,0 +1,6 @@
+// Package metricsource defines typed specs for the metric sources of popular monitoring backends.
+// OpenSLO keeps the metric source spec as a free-form object, its shape depends on the metric source type,
+// e.g. 'Prometheus' or 'CloudWatch'.
+// For every recognized [Type], the spec can be decoded into its typed counterpart with [DecodeSpec]
+// and is validated as part of the SLI validation.
+package metricsource
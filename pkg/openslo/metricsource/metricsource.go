@@ -0,0 +1,122 @@
+package metricsource
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nobl9/govy/pkg/govy"
+)
+
+// Type is the type of metric source, e.g. 'Prometheus'.
+// Types are matched case-insensitively, 'prometheus' and 'Prometheus' refer to the same [Type].
+type Type string
+
+const (
+	TypeAppDynamics   Type = "AppDynamics"
+	TypeCloudWatch    Type = "CloudWatch"
+	TypeDatadog       Type = "Datadog"
+	TypeElasticsearch Type = "Elasticsearch"
+	TypeGraphite      Type = "Graphite"
+	TypeNewRelic      Type = "NewRelic"
+	TypePrometheus    Type = "Prometheus"
+	TypeRedshift      Type = "Redshift"
+)
+
+// Spec is implemented by every typed metric source spec.
+type Spec interface {
+	// GetType returns the [Type] of the metric source the [Spec] is defined for.
+	GetType() Type
+	// Validate performs static validation of the [Spec].
+	Validate() error
+}
+
+// specEntry describes a typed [Spec] registered for a [Type].
+type specEntry struct {
+	decode func(spec map[string]any) (Spec, error)
+	// validator decodes the free-form spec and validates its typed counterpart.
+	validator govy.Validator[map[string]any]
+}
+
+var specs = map[Type]specEntry{
+	TypeAppDynamics:   newSpecEntry(appDynamicsSpecValidation),
+	TypeCloudWatch:    newSpecEntry(cloudWatchSpecValidation),
+	TypeDatadog:       newSpecEntry(datadogSpecValidation),
+	TypeElasticsearch: newSpecEntry(elasticsearchSpecValidation),
+	TypeGraphite:      newSpecEntry(graphiteSpecValidation),
+	TypeNewRelic:      newSpecEntry(newRelicSpecValidation),
+	TypePrometheus:    newSpecEntry(prometheusSpecValidation),
+	TypeRedshift:      newSpecEntry(redshiftSpecValidation),
+}
+
+func newSpecEntry[T Spec](validator govy.Validator[T]) specEntry {
+	return specEntry{
+		decode: func(spec map[string]any) (Spec, error) { return decodeSpec[T](spec) },
+		validator: govy.New(
+			govy.Transform(govy.GetSelf[map[string]any](), decodeSpec[T]).
+				Include(validator),
+		),
+	}
+}
+
+// GetSupportedTypes returns all the [Type] which have a typed [Spec] defined.
+func GetSupportedTypes() []Type {
+	types := make([]Type, 0, len(specs))
+	for typ := range specs {
+		types = append(types, typ)
+	}
+	slices.Sort(types)
+	return types
+}
+
+// ParseType returns the supported [Type] matching the provided metric source type.
+// If the type is not supported, false is returned.
+func ParseType(s string) (Type, bool) {
+	for typ := range specs {
+		if strings.EqualFold(string(typ), s) {
+			return typ, true
+		}
+	}
+	return "", false
+}
+
+// DecodeSpec decodes the free-form metric source spec into a typed [Spec] of the provided metric source type.
+// Fields which are not defined by the typed [Spec] are ignored.
+func DecodeSpec(sourceType string, spec map[string]any) (Spec, error) {
+	typ, ok := ParseType(sourceType)
+	if !ok {
+		return nil, fmt.Errorf("unsupported metric source type: %s", sourceType)
+	}
+	return specs[typ].decode(spec)
+}
+
+// NewValidator creates a [govy.Validator] for S, which defines a metric source type and its free-form spec.
+// If the type is supported, the spec is decoded into its typed [Spec] and validated.
+// The errors are reported for the 'spec' property of S.
+// Specs of unsupported types are not validated.
+func NewValidator[S any](getType func(S) string, getSpec func(S) map[string]any) govy.Validator[S] {
+	props := make([]govy.PropertyRulesInterface[S], 0, len(specs))
+	for _, typ := range GetSupportedTypes() {
+		props = append(props, govy.For(getSpec).
+			WithName("spec").
+			When(
+				func(s S) bool { return strings.EqualFold(getType(s), string(typ)) },
+				govy.WhenDescriptionf("'type' is '%s'", typ),
+			).
+			Include(specs[typ].validator))
+	}
+	return govy.New(props...)
+}
+
+func decodeSpec[T Spec](spec map[string]any) (T, error) {
+	var typed T
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return typed, err
+	}
+	if err = json.Unmarshal(data, &typed); err != nil {
+		return typed, fmt.Errorf("failed to decode %s metric source spec: %w", typed.GetType(), err)
+	}
+	return typed, nil
+}
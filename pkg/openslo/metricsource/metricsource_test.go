@@ -0,0 +1,128 @@
+package metricsource
+
+import (
+	"testing"
+
+	"github.com/nobl9/govy/pkg/govytest"
+	"github.com/nobl9/govy/pkg/rules"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+)
+
+func TestParseType(t *testing.T) {
+	for _, typ := range GetSupportedTypes() {
+		parsed, ok := ParseType(string(typ))
+		assert.True(t, ok)
+		assert.Equal(t, typ, parsed)
+	}
+	parsed, ok := ParseType("cloudWatch")
+	assert.True(t, ok)
+	assert.Equal(t, TypeCloudWatch, parsed)
+	_, ok = ParseType("Custom")
+	assert.False(t, ok)
+}
+
+func TestDecodeSpec(t *testing.T) {
+	spec, err := DecodeSpec("redshift", map[string]any{
+		"region":       "eu-central-1",
+		"clusterId":    "metrics-cluster",
+		"databaseName": "metrics-db",
+		"query":        "SELECT value, timestamp FROM metrics",
+		"unknown":      true,
+	})
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, Spec(RedshiftSpec{
+		Region:       "eu-central-1",
+		ClusterID:    "metrics-cluster",
+		DatabaseName: "metrics-db",
+		Query:        "SELECT value, timestamp FROM metrics",
+	}), spec)
+
+	_, err = DecodeSpec("Custom", map[string]any{"query": "up"})
+	assert.Require(t, assert.Error(t, err))
+	assert.Equal(t, "unsupported metric source type: Custom", err.Error())
+}
+
+func TestSpec_Validate(t *testing.T) {
+	tests := map[string]struct {
+		spec   Spec
+		errors []govytest.ExpectedRuleError
+	}{
+		"valid AppDynamics": {
+			spec: AppDynamicsSpec{ApplicationName: "my-app", MetricPath: "End User Experience|App|Slow Requests"},
+		},
+		"invalid AppDynamics": {
+			spec: AppDynamicsSpec{},
+			errors: []govytest.ExpectedRuleError{
+				{PropertyPath: "applicationName", Code: rules.ErrorCodeRequired},
+				{PropertyPath: "metricPath", Code: rules.ErrorCodeRequired},
+			},
+		},
+		"valid CloudWatch standard metric": {
+			spec: CloudWatchSpec{
+				Namespace:  "AWS/ApplicationELB",
+				MetricName: "HTTPCode_Target_2XX_Count",
+				Stat:       "SampleCount",
+				Dimensions: []CloudWatchDimension{{Name: "LoadBalancer", Value: "app/my-lb"}},
+			},
+		},
+		"valid CloudWatch JSON query": {
+			spec: CloudWatchSpec{JSON: `[{"Id":"e1","Expression":"m1/m2"}]`},
+		},
+		"invalid CloudWatch standard metric": {
+			spec: CloudWatchSpec{Dimensions: []CloudWatchDimension{{}}},
+			errors: []govytest.ExpectedRuleError{
+				{PropertyPath: "namespace", Code: rules.ErrorCodeRequired},
+				{PropertyPath: "metricName", Code: rules.ErrorCodeRequired},
+				{PropertyPath: "stat", Code: rules.ErrorCodeRequired},
+				{PropertyPath: "dimensions[0].name", Code: rules.ErrorCodeRequired},
+				{PropertyPath: "dimensions[0].value", Code: rules.ErrorCodeRequired},
+			},
+		},
+		"CloudWatch with both SQL and JSON": {
+			spec: CloudWatchSpec{SQL: "SELECT 1", JSON: "[]"},
+			errors: []govytest.ExpectedRuleError{
+				{Code: rules.ErrorCodeMutuallyExclusive},
+			},
+		},
+		"invalid Datadog": {
+			spec:   DatadogSpec{},
+			errors: []govytest.ExpectedRuleError{{PropertyPath: "query", Code: rules.ErrorCodeRequired}},
+		},
+		"invalid Elasticsearch": {
+			spec: ElasticsearchSpec{Query: `{"query":{"match_all":{}}}`},
+			errors: []govytest.ExpectedRuleError{
+				{PropertyPath: "index", Code: rules.ErrorCodeRequired},
+			},
+		},
+		"invalid Graphite": {
+			spec:   GraphiteSpec{},
+			errors: []govytest.ExpectedRuleError{{PropertyPath: "metricPath", Code: rules.ErrorCodeRequired}},
+		},
+		"invalid NewRelic": {
+			spec:   NewRelicSpec{},
+			errors: []govytest.ExpectedRuleError{{PropertyPath: "nrql", Code: rules.ErrorCodeRequired}},
+		},
+		"valid Prometheus": {
+			spec: PrometheusSpec{Query: "sum(http_requests_total)"},
+		},
+		"invalid Prometheus": {
+			spec:   PrometheusSpec{},
+			errors: []govytest.ExpectedRuleError{{PropertyPath: "query", Code: rules.ErrorCodeRequired}},
+		},
+		"invalid Redshift": {
+			spec:   RedshiftSpec{Region: "eu-central-1"},
+			errors: []govytest.ExpectedRuleError{{PropertyPath: "query", Code: rules.ErrorCodeRequired}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.spec.Validate()
+			if len(tc.errors) == 0 {
+				govytest.AssertNoError(t, err)
+			} else {
+				govytest.AssertError(t, err, tc.errors...)
+			}
+		})
+	}
+}
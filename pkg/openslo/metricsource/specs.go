@@ -0,0 +1,180 @@
+package metricsource
+
+import (
+	"github.com/nobl9/govy/pkg/govy"
+	"github.com/nobl9/govy/pkg/rules"
+)
+
+// AppDynamicsSpec is the metric source spec of [TypeAppDynamics].
+type AppDynamicsSpec struct {
+	ApplicationName string `json:"applicationName"`
+	MetricPath      string `json:"metricPath"`
+}
+
+func (s AppDynamicsSpec) GetType() Type { return TypeAppDynamics }
+
+func (s AppDynamicsSpec) Validate() error { return appDynamicsSpecValidation.Validate(s) }
+
+var appDynamicsSpecValidation = govy.New(
+	govy.For(func(s AppDynamicsSpec) string { return s.ApplicationName }).
+		WithName("applicationName").
+		Required(),
+	govy.For(func(s AppDynamicsSpec) string { return s.MetricPath }).
+		WithName("metricPath").
+		Required(),
+)
+
+// CloudWatchSpec is the metric source spec of [TypeCloudWatch].
+// The metric is either defined with [CloudWatchSpec.Namespace], [CloudWatchSpec.MetricName]
+// and [CloudWatchSpec.Stat], or with a query provided through [CloudWatchSpec.SQL] or [CloudWatchSpec.JSON].
+type CloudWatchSpec struct {
+	Region     string                `json:"region,omitempty"`
+	Namespace  string                `json:"namespace,omitempty"`
+	MetricName string                `json:"metricName,omitempty"`
+	Stat       string                `json:"stat,omitempty"`
+	Dimensions []CloudWatchDimension `json:"dimensions,omitempty"`
+	SQL        string                `json:"sql,omitempty"`
+	JSON       string                `json:"json,omitempty"`
+}
+
+type CloudWatchDimension struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func (s CloudWatchSpec) GetType() Type { return TypeCloudWatch }
+
+func (s CloudWatchSpec) Validate() error { return cloudWatchSpecValidation.Validate(s) }
+
+func (s CloudWatchSpec) isStandardMetric() bool {
+	return s.SQL == "" && s.JSON == ""
+}
+
+var cloudWatchSpecValidation = govy.New(
+	govy.For(govy.GetSelf[CloudWatchSpec]()).
+		Rules(rules.MutuallyExclusive(false, map[string]func(s CloudWatchSpec) any{
+			"sql":  func(s CloudWatchSpec) any { return s.SQL },
+			"json": func(s CloudWatchSpec) any { return s.JSON },
+		})),
+	govy.For(func(s CloudWatchSpec) string { return s.Namespace }).
+		WithName("namespace").
+		When(CloudWatchSpec.isStandardMetric, govy.WhenDescription("neither 'sql' nor 'json' is set")).
+		Required(),
+	govy.For(func(s CloudWatchSpec) string { return s.MetricName }).
+		WithName("metricName").
+		When(CloudWatchSpec.isStandardMetric, govy.WhenDescription("neither 'sql' nor 'json' is set")).
+		Required(),
+	govy.For(func(s CloudWatchSpec) string { return s.Stat }).
+		WithName("stat").
+		When(CloudWatchSpec.isStandardMetric, govy.WhenDescription("neither 'sql' nor 'json' is set")).
+		Required(),
+	govy.ForSlice(func(s CloudWatchSpec) []CloudWatchDimension { return s.Dimensions }).
+		WithName("dimensions").
+		IncludeForEach(govy.New(
+			govy.For(func(d CloudWatchDimension) string { return d.Name }).
+				WithName("name").
+				Required(),
+			govy.For(func(d CloudWatchDimension) string { return d.Value }).
+				WithName("value").
+				Required(),
+		)),
+)
+
+// DatadogSpec is the metric source spec of [TypeDatadog].
+type DatadogSpec struct {
+	Query string `json:"query"`
+}
+
+func (s DatadogSpec) GetType() Type { return TypeDatadog }
+
+func (s DatadogSpec) Validate() error { return datadogSpecValidation.Validate(s) }
+
+var datadogSpecValidation = govy.New(
+	govy.For(func(s DatadogSpec) string { return s.Query }).
+		WithName("query").
+		Required(),
+)
+
+// ElasticsearchSpec is the metric source spec of [TypeElasticsearch].
+type ElasticsearchSpec struct {
+	Index string `json:"index"`
+	Query string `json:"query"`
+}
+
+func (s ElasticsearchSpec) GetType() Type { return TypeElasticsearch }
+
+func (s ElasticsearchSpec) Validate() error { return elasticsearchSpecValidation.Validate(s) }
+
+var elasticsearchSpecValidation = govy.New(
+	govy.For(func(s ElasticsearchSpec) string { return s.Index }).
+		WithName("index").
+		Required(),
+	govy.For(func(s ElasticsearchSpec) string { return s.Query }).
+		WithName("query").
+		Required(),
+)
+
+// GraphiteSpec is the metric source spec of [TypeGraphite].
+type GraphiteSpec struct {
+	MetricPath string `json:"metricPath"`
+}
+
+func (s GraphiteSpec) GetType() Type { return TypeGraphite }
+
+func (s GraphiteSpec) Validate() error { return graphiteSpecValidation.Validate(s) }
+
+var graphiteSpecValidation = govy.New(
+	govy.For(func(s GraphiteSpec) string { return s.MetricPath }).
+		WithName("metricPath").
+		Required(),
+)
+
+// NewRelicSpec is the metric source spec of [TypeNewRelic].
+type NewRelicSpec struct {
+	NRQL string `json:"nrql"`
+}
+
+func (s NewRelicSpec) GetType() Type { return TypeNewRelic }
+
+func (s NewRelicSpec) Validate() error { return newRelicSpecValidation.Validate(s) }
+
+var newRelicSpecValidation = govy.New(
+	govy.For(func(s NewRelicSpec) string { return s.NRQL }).
+		WithName("nrql").
+		Required(),
+)
+
+// PrometheusSpec is the metric source spec of [TypePrometheus].
+type PrometheusSpec struct {
+	Query string `json:"query"`
+}
+
+func (s PrometheusSpec) GetType() Type { return TypePrometheus }
+
+func (s PrometheusSpec) Validate() error { return prometheusSpecValidation.Validate(s) }
+
+var prometheusSpecValidation = govy.New(
+	govy.For(func(s PrometheusSpec) string { return s.Query }).
+		WithName("query").
+		Required(),
+)
+
+// RedshiftSpec is the metric source spec of [TypeRedshift].
+// The cluster details are often provided through the data source connection details,
+// only the query is required.
+type RedshiftSpec struct {
+	Region       string `json:"region,omitempty"`
+	ClusterID    string `json:"clusterId,omitempty"`
+	DatabaseName string `json:"databaseName,omitempty"`
+	Query        string `json:"query"`
+}
+
+func (s RedshiftSpec) GetType() Type { return TypeRedshift }
+
+func (s RedshiftSpec) Validate() error { return redshiftSpecValidation.Validate(s) }
+
+var redshiftSpecValidation = govy.New(
+	govy.For(func(s RedshiftSpec) string { return s.Query }).
+		WithName("query").
+		Required(),
+)
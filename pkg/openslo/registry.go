@@ -0,0 +1,105 @@
+package openslo
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// ObjectConstructor creates a new instance of an [Object].
+// The returned [Object] is used as a template when decoding objects of the registered [Version] and [Kind].
+// It can be either a value or a pointer, decoding preserves whatever the constructor returns.
+type ObjectConstructor func() Object
+
+var registry = &objectRegistry{constructors: make(map[Version]map[Kind]ObjectConstructor)}
+
+type objectRegistry struct {
+	mu           sync.RWMutex
+	constructors map[Version]map[Kind]ObjectConstructor
+}
+
+// RegisterObject registers an [ObjectConstructor] for the [Version] and [Kind] pair.
+// Once registered, the pair is accepted by [Version.Validate] and [Kind.Validate],
+// and objects of this type can be decoded by the SDK.
+// Built-in OpenSLO objects are registered by their respective version packages.
+//
+// An error is returned if the pair is already registered.
+func RegisterObject(version Version, kind Kind, constructor ObjectConstructor) error {
+	if version == "" || kind == "" {
+		return fmt.Errorf("both %T and %T must be provided", version, kind)
+	}
+	if constructor == nil {
+		return fmt.Errorf("%T for %s %s must not be nil", constructor, version, kind)
+	}
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	kinds, ok := registry.constructors[version]
+	if !ok {
+		kinds = make(map[Kind]ObjectConstructor)
+		registry.constructors[version] = kinds
+	}
+	if _, ok = kinds[kind]; ok {
+		return fmt.Errorf("%s %s is already registered", version, kind)
+	}
+	kinds[kind] = constructor
+	return nil
+}
+
+// MustRegisterObject is like [RegisterObject] but panics if the registration fails.
+// It is intended to be used in package init functions.
+func MustRegisterObject(version Version, kind Kind, constructor ObjectConstructor) {
+	if err := RegisterObject(version, kind, constructor); err != nil {
+		panic(err)
+	}
+}
+
+// GetObjectConstructor returns the [ObjectConstructor] registered for the [Version] and [Kind] pair.
+// If the pair is not registered, false is returned.
+func GetObjectConstructor(version Version, kind Kind) (ObjectConstructor, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	constructor, ok := registry.constructors[version][kind]
+	return constructor, ok
+}
+
+// GetRegisteredVersions returns all the [Version] which have at least one [Kind] registered.
+func GetRegisteredVersions() []Version {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	versions := make([]Version, 0, len(registry.constructors))
+	for version := range registry.constructors {
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+	return versions
+}
+
+// GetRegisteredKinds returns all the [Kind] registered for the [Version].
+func GetRegisteredKinds(version Version) []Kind {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	kinds := make([]Kind, 0, len(registry.constructors[version]))
+	for kind := range registry.constructors[version] {
+		kinds = append(kinds, kind)
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+func isVersionRegistered(version Version) bool {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	_, ok := registry.constructors[version]
+	return ok
+}
+
+func isKindRegistered(kind Kind) bool {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	for _, kinds := range registry.constructors {
+		if _, ok := kinds[kind]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package openslo
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+)
+
+type registryTestObject struct{}
+
+func (registryTestObject) String() string      { return "registryTestObject" }
+func (registryTestObject) GetVersion() Version { return "registry.test/v1" }
+func (registryTestObject) GetKind() Kind       { return "RegistryTestObject" }
+func (registryTestObject) GetName() string     { return "" }
+func (registryTestObject) Validate() error     { return nil }
+
+func TestRegisterObject(t *testing.T) {
+	version := Version("registry.test/v1")
+	kind := Kind("RegistryTestObject")
+	constructor := func() Object { return registryTestObject{} }
+
+	assert.Error(t, version.Validate())
+	assert.Error(t, kind.Validate())
+	_, ok := GetObjectConstructor(version, kind)
+	assert.False(t, ok)
+
+	err := RegisterObject(version, kind, constructor)
+	assert.Require(t, assert.NoError(t, err))
+
+	assert.NoError(t, version.Validate())
+	assert.NoError(t, kind.Validate())
+	registered, ok := GetObjectConstructor(version, kind)
+	assert.Require(t, assert.True(t, ok))
+	assert.Equal(t, Object(registryTestObject{}), registered())
+	assert.Equal(t, []Kind{kind}, GetRegisteredKinds(version))
+	assert.True(t, slices.Contains(GetRegisteredVersions(), version))
+
+	t.Run("duplicate", func(t *testing.T) {
+		err = RegisterObject(version, kind, constructor)
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "registry.test/v1 RegistryTestObject is already registered", err.Error())
+	})
+	t.Run("nil constructor", func(t *testing.T) {
+		err = RegisterObject(version, "Other", nil)
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "openslo.ObjectConstructor for registry.test/v1 Other must not be nil", err.Error())
+	})
+	t.Run("empty kind", func(t *testing.T) {
+		err = RegisterObject(version, "", constructor)
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "both openslo.Version and openslo.Kind must be provided", err.Error())
+	})
+}
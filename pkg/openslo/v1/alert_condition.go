@@ -126,4 +126,7 @@ var alertConditionBurnRateValidation = govy.New(
 		WithName("alertAfter").
 		Include(durationShortHandValidation),
 ).
-	When(func(a AlertConditionType) bool { return a.Kind == AlertConditionKindBurnRate })
+	When(
+		func(a AlertConditionType) bool { return a.Kind == AlertConditionKindBurnRate },
+		govy.WhenDescription("'kind' is 'burnrate'"),
+	)
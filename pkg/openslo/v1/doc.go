@@ -1,2 +1,4 @@
 // Package v1 contains the OpenSLO specification version v1alpha definitions.
 package v1
+
+//go:generate go -C ../../../internal/cmd/objectgen run . -dir $PWD
@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+
+	"github.com/OpenSLO/go-sdk/internal"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/metricsource"
+)
+
+// Normalize returns a deep copy of the SLO with equivalent forms converted to a single, canonical one:
+//   - [SLOObjective.TargetPercent] is converted to [SLOObjective.Target]
+//   - [Metadata] is normalized, see [Metadata.Normalize]
+//   - inlined objects are normalized the same way their standalone counterparts are
+func (s SLO) Normalize() SLO {
+	s = s.DeepCopy()
+	s.Metadata = s.Metadata.Normalize()
+	if s.Spec.Indicator != nil {
+		s.Spec.Indicator.Metadata = s.Spec.Indicator.Metadata.Normalize()
+		s.Spec.Indicator.Spec = normalizeSLISpec(s.Spec.Indicator.Spec)
+	}
+	for i := range s.Spec.Objectives {
+		s.Spec.Objectives[i] = normalizeSLOObjective(s.Spec.Objectives[i])
+	}
+	for i := range s.Spec.AlertPolicies {
+		if s.Spec.AlertPolicies[i].SLOAlertPolicyInline != nil {
+			s.Spec.AlertPolicies[i].Metadata = s.Spec.AlertPolicies[i].Metadata.Normalize()
+			s.Spec.AlertPolicies[i].Spec = normalizeAlertPolicySpec(s.Spec.AlertPolicies[i].Spec)
+		}
+	}
+	return s
+}
+
+// Normalize returns a deep copy of the SLI with equivalent forms converted to a single, canonical one:
+//   - [SLIMetricSource.Type] of supported metric sources is spelled as defined by [metricsource.Type]
+//   - [Metadata] is normalized, see [Metadata.Normalize]
+func (s SLI) Normalize() SLI {
+	s = s.DeepCopy()
+	s.Metadata = s.Metadata.Normalize()
+	s.Spec = normalizeSLISpec(s.Spec)
+	return s
+}
+
+// Normalize returns a deep copy of the DataSource with equivalent forms converted to a single, canonical one:
+//   - [DataSourceSpec.Type] of supported metric sources is spelled as defined by [metricsource.Type]
+//   - [DataSourceSpec.ConnectionDetails] are compacted, insignificant whitespace is removed
+//   - [Metadata] is normalized, see [Metadata.Normalize]
+func (d DataSource) Normalize() DataSource {
+	d = d.DeepCopy()
+	d.Metadata = d.Metadata.Normalize()
+	d.Spec.Type = normalizeMetricSourceType(d.Spec.Type)
+	d.Spec.ConnectionDetails = normalizeConnectionDetails(d.Spec.ConnectionDetails)
+	return d
+}
+
+// Normalize returns a deep copy of the Service with its [Metadata] normalized, see [Metadata.Normalize].
+func (s Service) Normalize() Service {
+	s = s.DeepCopy()
+	s.Metadata = s.Metadata.Normalize()
+	return s
+}
+
+// Normalize returns a deep copy of the AlertPolicy with its [Metadata] normalized, see [Metadata.Normalize].
+// Inlined objects are normalized the same way their standalone counterparts are.
+func (a AlertPolicy) Normalize() AlertPolicy {
+	a = a.DeepCopy()
+	a.Metadata = a.Metadata.Normalize()
+	a.Spec = normalizeAlertPolicySpec(a.Spec)
+	return a
+}
+
+// Normalize returns a deep copy of the AlertCondition with its [Metadata] normalized, see [Metadata.Normalize].
+func (a AlertCondition) Normalize() AlertCondition {
+	a = a.DeepCopy()
+	a.Metadata = a.Metadata.Normalize()
+	return a
+}
+
+// Normalize returns a deep copy of the AlertNotificationTarget with its [Metadata] normalized,
+// see [Metadata.Normalize].
+func (a AlertNotificationTarget) Normalize() AlertNotificationTarget {
+	a = a.DeepCopy()
+	a.Metadata = a.Metadata.Normalize()
+	return a
+}
+
+// Normalize returns a deep copy of the Metadata with equivalent forms converted to a single, canonical one:
+//   - [Label] values are sorted and deduplicated, a single value label is equivalent to a list with one value
+//   - empty [Labels] and [Annotations] are set to nil
+func (m Metadata) Normalize() Metadata {
+	m = m.DeepCopy()
+	for key, values := range m.Labels {
+		slices.Sort(values)
+		m.Labels[key] = slices.Compact(values)
+	}
+	if len(m.Labels) == 0 {
+		m.Labels = nil
+	}
+	if len(m.Annotations) == 0 {
+		m.Annotations = nil
+	}
+	return m
+}
+
+// normalizeSLOObjective converts TargetPercent to Target.
+// If both are set, the objective is invalid and is left as is.
+func normalizeSLOObjective(objective SLOObjective) SLOObjective {
+	if objective.TargetPercent != nil && objective.Target == nil {
+		target := internal.PercentToRatio(*objective.TargetPercent)
+		objective.Target = &target
+		objective.TargetPercent = nil
+	}
+	if objective.Indicator != nil {
+		objective.Indicator.Metadata = objective.Indicator.Metadata.Normalize()
+		objective.Indicator.Spec = normalizeSLISpec(objective.Indicator.Spec)
+	}
+	return objective
+}
+
+func normalizeSLISpec(spec SLISpec) SLISpec {
+	metricSpecs := []*SLIMetricSpec{spec.ThresholdMetric}
+	if spec.RatioMetric != nil {
+		metricSpecs = append(metricSpecs,
+			spec.RatioMetric.Good,
+			spec.RatioMetric.Bad,
+			spec.RatioMetric.Total,
+			spec.RatioMetric.Raw,
+		)
+	}
+	for _, metricSpec := range metricSpecs {
+		if metricSpec != nil {
+			metricSpec.MetricSource.Type = normalizeMetricSourceType(metricSpec.MetricSource.Type)
+		}
+	}
+	return spec
+}
+
+func normalizeAlertPolicySpec(spec AlertPolicySpec) AlertPolicySpec {
+	for i := range spec.Conditions {
+		if spec.Conditions[i].AlertPolicyConditionInline != nil {
+			spec.Conditions[i].Metadata = spec.Conditions[i].Metadata.Normalize()
+		}
+	}
+	for i := range spec.NotificationTargets {
+		if spec.NotificationTargets[i].AlertPolicyNotificationTargetInline != nil {
+			spec.NotificationTargets[i].Metadata = spec.NotificationTargets[i].Metadata.Normalize()
+		}
+	}
+	return spec
+}
+
+func normalizeMetricSourceType(typ string) string {
+	if parsed, ok := metricsource.ParseType(typ); ok {
+		return string(parsed)
+	}
+	return typ
+}
+
+func normalizeConnectionDetails(details json.RawMessage) json.RawMessage {
+	if len(details) == 0 {
+		return details
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, details); err != nil {
+		return details
+	}
+	return buf.Bytes()
+}
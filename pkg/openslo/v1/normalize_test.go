@@ -0,0 +1,182 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+)
+
+func TestSLO_DeepCopy(t *testing.T) {
+	slo := validRatioSLOWithInlinedAlertPolicy()
+
+	copied := slo.DeepCopy()
+	assert.True(t, copied.Equal(slo))
+
+	copied.Metadata.Labels["team"][0] = "team-c"
+	copied.Spec.Indicator.Spec.RatioMetric.Good.MetricSource.Spec["query"] = "up"
+	*copied.Spec.Objectives[0].Target = 0.9
+	copied.Spec.TimeWindow[0].Calendar.TimeZone = "UTC"
+	copied.Spec.AlertPolicies[0].Metadata.Name = "copied"
+	copied.Spec.AlertPolicies[0].Spec.Conditions[0].ConditionRef = "copied"
+
+	assert.False(t, copied.Equal(slo))
+	assert.True(t, slo.Equal(validRatioSLOWithInlinedAlertPolicy()))
+}
+
+func TestAlertPolicy_DeepCopy(t *testing.T) {
+	alertPolicy := validAlertPolicyWithInlineDefinitions()
+
+	copied := alertPolicy.DeepCopy()
+	assert.True(t, copied.Equal(alertPolicy))
+
+	*copied.Spec.Conditions[0].Spec.Condition.Threshold = 100
+	copied.Spec.NotificationTargets[0].Metadata.Annotations = Annotations{"key": "value"}
+
+	assert.False(t, copied.Equal(alertPolicy))
+	assert.True(t, alertPolicy.Equal(validAlertPolicyWithInlineDefinitions()))
+}
+
+func TestDataSource_DeepCopy(t *testing.T) {
+	dataSource := validDataSource()
+
+	copied := dataSource.DeepCopy()
+	assert.True(t, copied.Equal(dataSource))
+
+	copied.Spec.ConnectionDetails[0] = '{'
+
+	assert.False(t, copied.Equal(dataSource))
+	assert.True(t, dataSource.Equal(validDataSource()))
+}
+
+func TestSLO_Equal(t *testing.T) {
+	tests := map[string]struct {
+		modify   func(s *SLO)
+		expected bool
+	}{
+		"same object": {
+			modify:   func(*SLO) {},
+			expected: true,
+		},
+		"nil and empty slices": {
+			modify:   func(s *SLO) { s.Spec.Indicator.Metadata.Labels = Labels{} },
+			expected: true,
+		},
+		"different pointer values": {
+			modify:   func(s *SLO) { s.Spec.Objectives[0].Target = ptr(0.99) },
+			expected: false,
+		},
+		"nil pointer": {
+			modify:   func(s *SLO) { s.Spec.Objectives[0].TimeSliceWindow = nil },
+			expected: false,
+		},
+		"different label values order": {
+			modify:   func(s *SLO) { s.Metadata.Labels["team"] = Label{"team-b", "team-a"} },
+			expected: false,
+		},
+		"different metric source spec": {
+			modify: func(s *SLO) {
+				s.Spec.Indicator.Spec.RatioMetric.Total.MetricSource.Spec["query"] = map[string]any{"nested": true}
+			},
+			expected: false,
+		},
+		"different duration shorthand": {
+			modify: func(s *SLO) {
+				s.Spec.TimeWindow[0].Duration = NewDurationShorthand(7, DurationShorthandUnitDay)
+			},
+			expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			slo := validRatioSLO()
+			tc.modify(&slo)
+			assert.Equal(t, tc.expected, validRatioSLO().Equal(slo))
+			assert.Equal(t, tc.expected, slo.Equal(validRatioSLO()))
+		})
+	}
+}
+
+func TestSLO_Normalize(t *testing.T) {
+	slo := validCompositeSLOWithInlinedSLI()
+	slo.Metadata.Labels["team"] = Label{"team-b", "team-a", "team-b"}
+	slo.Metadata.Annotations = Annotations{}
+	slo.Spec.Objectives[0].Target = nil
+	slo.Spec.Objectives[0].TargetPercent = ptr(99.5)
+	slo.Spec.Objectives[0].Indicator.Spec.RatioMetric.Good.MetricSource.Type = "datadog"
+	slo.Spec.Objectives[0].Indicator.Metadata.Labels = Labels{}
+
+	normalized := slo.Normalize()
+
+	expected := validCompositeSLOWithInlinedSLI()
+	expected.Metadata.Labels["team"] = Label{"team-a", "team-b"}
+	expected.Spec.Objectives[0].Indicator.Metadata.Labels = nil
+	assert.Equal(t, expected, normalized)
+	assert.True(t, expected.Equal(normalized))
+	// The original object is not modified.
+	assert.Equal(t, Label{"team-b", "team-a", "team-b"}, slo.Metadata.Labels["team"])
+	assert.Equal(t, 99.5, *slo.Spec.Objectives[0].TargetPercent)
+}
+
+func TestSLO_Normalize_InlinedAlertPolicy(t *testing.T) {
+	slo := validRatioSLOWithInlinedAlertPolicy()
+	slo.Spec.AlertPolicies[0].Metadata.Labels = Labels{"team": {"team-b", "team-a"}}
+
+	normalized := slo.Normalize()
+
+	assert.Equal(t, Labels{"team": {"team-a", "team-b"}}, normalized.Spec.AlertPolicies[0].Metadata.Labels)
+	assert.Equal(t, Labels{"team": {"team-b", "team-a"}}, slo.Spec.AlertPolicies[0].Metadata.Labels)
+}
+
+func TestSLO_Normalize_BothTargets(t *testing.T) {
+	slo := validRatioSLO()
+	slo.Spec.Objectives[0].TargetPercent = ptr(99.0)
+
+	normalized := slo.Normalize()
+
+	assert.Equal(t, 0.995, *normalized.Spec.Objectives[0].Target)
+	assert.Equal(t, 99.0, *normalized.Spec.Objectives[0].TargetPercent)
+}
+
+func TestSLO_Normalize_TargetPercent(t *testing.T) {
+	for _, tc := range []struct {
+		targetPercent float64
+		target        float64
+	}{
+		{targetPercent: 99, target: 0.99},
+		{targetPercent: 99.9, target: 0.999},
+		{targetPercent: 99.99, target: 0.9999},
+	} {
+		slo := validRatioSLO()
+		slo.Spec.Objectives[0].Target = nil
+		slo.Spec.Objectives[0].TargetPercent = ptr(tc.targetPercent)
+		expected := validRatioSLO()
+		expected.Spec.Objectives[0].Target = ptr(tc.target)
+
+		normalized := slo.Normalize()
+
+		assert.Equal(t, tc.target, *normalized.Spec.Objectives[0].Target)
+		assert.True(t, normalized.Equal(expected.Normalize()))
+	}
+}
+
+func TestDataSource_Normalize(t *testing.T) {
+	dataSource := validDataSource()
+	dataSource.Spec.Type = "PROMETHEUS"
+	dataSource.Spec.ConnectionDetails = []byte(`[
+  { "url": "http://prometheus.example.com" }
+]`)
+
+	normalized := dataSource.Normalize()
+
+	assert.Equal(t, validDataSource(), normalized)
+}
+
+func TestAlertPolicy_Normalize(t *testing.T) {
+	alertPolicy := validAlertPolicyWithInlineDefinitions()
+	alertPolicy.Spec.Conditions[0].Metadata.Labels = Labels{"env": {"prod", "prod"}}
+
+	normalized := alertPolicy.Normalize()
+
+	assert.Equal(t, Labels{"env": {"prod"}}, normalized.Spec.Conditions[0].Metadata.Labels)
+	assert.Equal(t, Labels{"env": {"prod", "prod"}}, alertPolicy.Spec.Conditions[0].Metadata.Labels)
+}
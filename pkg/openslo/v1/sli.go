@@ -6,6 +6,7 @@ import (
 
 	"github.com/OpenSLO/go-sdk/internal"
 	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/metricsource"
 )
 
 var (
@@ -149,16 +150,25 @@ var sliFractionMetricValidation = govy.New(
 	govy.ForPointer(func(m SLIRatioMetric) *SLIMetricSpec { return m.Good }).
 		WithName("good").
 		Cascade(govy.CascadeModeContinue).
-		When(func(m SLIRatioMetric) bool { return m.Good != nil }).
+		When(
+			func(m SLIRatioMetric) bool { return m.Good != nil },
+			govy.WhenDescription("'good' is set"),
+		).
 		Include(sliMetricSpecValidation),
 	govy.ForPointer(func(m SLIRatioMetric) *SLIMetricSpec { return m.Bad }).
 		WithName("bad").
 		Cascade(govy.CascadeModeContinue).
-		When(func(m SLIRatioMetric) bool { return m.Bad != nil }).
+		When(
+			func(m SLIRatioMetric) bool { return m.Bad != nil },
+			govy.WhenDescription("'bad' is set"),
+		).
 		Include(sliMetricSpecValidation),
 ).
 	Cascade(govy.CascadeModeStop).
-	When(func(m SLIRatioMetric) bool { return m.Total != nil })
+	When(
+		func(m SLIRatioMetric) bool { return m.Total != nil },
+		govy.WhenDescription("'total' is set"),
+	)
 
 var sliRawMetricSpecValidation = govy.New(
 	govy.ForPointer(func(m SLIRatioMetric) *SLIMetricSpec { return m.Raw }).
@@ -169,7 +179,10 @@ var sliRawMetricSpecValidation = govy.New(
 		Required().
 		Rules(rules.OneOf(validSLIRawMetricTypes...)),
 ).
-	When(func(m SLIRatioMetric) bool { return m.Raw != nil })
+	When(
+		func(m SLIRatioMetric) bool { return m.Raw != nil },
+		govy.WhenDescription("'raw' is set"),
+	)
 
 var sliMetricSpecValidation = govy.New(
 	govy.For(func(spec SLIMetricSpec) SLIMetricSource { return spec.MetricSource }).
@@ -184,5 +197,10 @@ var sliMetricSpecValidation = govy.New(
 				WithName("spec").
 				Required().
 				Rules(rules.MapMinLength[map[string]any](1)),
+			govy.For(govy.GetSelf[SLIMetricSource]()).
+				Include(metricsource.NewValidator(
+					func(source SLIMetricSource) string { return source.Type },
+					func(source SLIMetricSource) map[string]any { return source.Spec },
+				)),
 		)),
 )
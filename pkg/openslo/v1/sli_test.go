@@ -274,6 +274,43 @@ func runSLIMetricSpecTests[T openslo.Object](t *testing.T, path string, objectGe
 			Code:         rules.ErrorCodeStringDNSLabel,
 		})
 	})
+	t.Run("typed metricSource.spec", func(t *testing.T) {
+		metricSpec := SLIMetricSpec{
+			MetricSource: SLIMetricSource{
+				Type: "CloudWatch",
+				Spec: map[string]any{"region": "eu-central-1", "namespace": "AWS/ELB"},
+			},
+		}
+		err := objectGetter(metricSpec).Validate()
+		govytest.AssertError(t, err,
+			govytest.ExpectedRuleError{
+				PropertyPath: path + ".metricSource.spec.metricName",
+				Code:         rules.ErrorCodeRequired,
+			},
+			govytest.ExpectedRuleError{
+				PropertyPath: path + ".metricSource.spec.stat",
+				Code:         rules.ErrorCodeRequired,
+			},
+		)
+
+		metricSpec.MetricSource.Spec = map[string]any{"sql": "SELECT AVG(CPUUtilization) FROM SCHEMA(\"AWS/EC2\")"}
+		err = objectGetter(metricSpec).Validate()
+		govytest.AssertNoError(t, err)
+	})
+	t.Run("invalid typed metricSource.spec", func(t *testing.T) {
+		object := objectGetter(SLIMetricSpec{
+			MetricSource: SLIMetricSource{
+				Type: "Prometheus",
+				Spec: map[string]any{"query": 1},
+			},
+		})
+		err := object.Validate()
+		govytest.AssertError(t, err, govytest.ExpectedRuleError{
+			PropertyPath: path + ".metricSource.spec",
+			Message: "failed to decode Prometheus metric source spec: json: cannot unmarshal number" +
+				" into Go struct field PrometheusSpec.query of type string",
+		})
+	})
 }
 
 func validSLI() SLI {
@@ -122,6 +122,24 @@ type SLOTimeWindow struct {
 	Calendar  *SLOCalendar      `json:"calendar,omitempty"`
 }
 
+// Bounds returns the start (inclusive) and end (exclusive) of the budgeting window which contains t.
+// Rolling windows end at t, while calendar-aligned windows are anchored at [SLOCalendar.StartTime]
+// and their bounds are returned in [SLOCalendar.TimeZone].
+// Unlike [DurationShorthand.Duration], calendar units like months are not approximated,
+// their actual length in the relevant time zone is used instead.
+func (s SLOTimeWindow) Bounds(t time.Time) (start, end time.Time, err error) {
+	w := internal.TimeWindow{
+		Unit:      string(s.Duration.GetUnit()),
+		Value:     s.Duration.GetValue(),
+		IsRolling: s.IsRolling,
+	}
+	if s.Calendar != nil {
+		w.StartTime = s.Calendar.StartTime
+		w.TimeZone = s.Calendar.TimeZone
+	}
+	return w.Bounds(t)
+}
+
 type SLOCalendar struct {
 	StartTime string `json:"startTime"`
 	TimeZone  string `json:"timeZone"`
@@ -196,7 +214,10 @@ var sloSpecValidation = govy.New(
 		),
 	govy.ForSlice(func(spec SLOSpec) []SLOObjective { return spec.Objectives }).
 		WithName("objectives").
-		When(func(s SLOSpec) bool { return s.HasCompositeObjectives() }).
+		When(
+			func(s SLOSpec) bool { return s.HasCompositeObjectives() },
+			govy.WhenDescription("is composite SLO"),
+		).
 		IncludeForEach(sloCompositeObjectiveValidation),
 )
 
@@ -225,7 +246,10 @@ func getSLOIndicatorValidation[T any](
 	).
 		// Another validation rule on 'spec' level already checks a scenario
 		// in which neither 'indicator' nor 'indicatorRef' are provided.
-		When(func(t T) bool { return indicatorGetter(t) != nil || indicatorRefGetter(t) != nil }).
+		When(
+			func(t T) bool { return indicatorGetter(t) != nil || indicatorRefGetter(t) != nil },
+			govy.WhenDescription("'indicator' or 'indicatorRef' is set"),
+		).
 		Cascade(govy.CascadeModeStop)
 }
 
@@ -351,7 +375,10 @@ var sloTimeSlicesObjectiveValidation = govy.New(
 			validationRulesForTimeSliceWindow(),
 		)),
 ).
-	When(func(s SLOSpec) bool { return s.BudgetingMethod == SLOBudgetingMethodTimeslices })
+	When(
+		func(s SLOSpec) bool { return s.BudgetingMethod == SLOBudgetingMethodTimeslices },
+		govy.WhenDescription("'budgetingMethod' is 'Timeslices'"),
+	)
 
 var sloRatioTimeSlicesObjectiveValidation = govy.New(
 	govy.ForSlice(func(spec SLOSpec) []SLOObjective { return spec.Objectives }).
@@ -360,7 +387,10 @@ var sloRatioTimeSlicesObjectiveValidation = govy.New(
 			validationRulesForTimeSliceWindow(),
 		)),
 ).
-	When(func(s SLOSpec) bool { return s.BudgetingMethod == SLOBudgetingMethodRatioTimeslices })
+	When(
+		func(s SLOSpec) bool { return s.BudgetingMethod == SLOBudgetingMethodRatioTimeslices },
+		govy.WhenDescription("'budgetingMethod' is 'RatioTimeslices'"),
+	)
 
 func validationRulesForTimeSliceWindow() govy.PropertyRules[DurationShorthand, SLOObjective] {
 	return govy.ForPointer(func(s SLOObjective) *DurationShorthand { return s.TimeSliceWindow }).
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/nobl9/govy/pkg/govy"
 	"github.com/nobl9/govy/pkg/govytest"
@@ -715,3 +716,30 @@ func validCompositeSLOWithInlinedSLI() SLO {
 	slo.Spec.Objectives[0].CompositeWeight = ptr(1.0)
 	return slo
 }
+
+func TestSLOTimeWindow_Bounds(t *testing.T) {
+	at := time.Date(2024, 2, 20, 12, 0, 0, 0, time.UTC)
+	t.Run("rolling", func(t *testing.T) {
+		window := SLOTimeWindow{
+			Duration:  NewDurationShorthand(1, DurationShorthandUnitDay),
+			IsRolling: true,
+		}
+		start, end, err := window.Bounds(at)
+		assert.Require(t, assert.NoError(t, err))
+		assert.True(t, start.Equal(time.Date(2024, 2, 19, 12, 0, 0, 0, time.UTC)))
+		assert.True(t, end.Equal(at))
+	})
+	t.Run("calendar", func(t *testing.T) {
+		window := SLOTimeWindow{
+			Duration: NewDurationShorthand(1, DurationShorthandUnitMonth),
+			Calendar: &SLOCalendar{
+				StartTime: "2024-01-01 00:00:00",
+				TimeZone:  "UTC",
+			},
+		}
+		start, end, err := window.Bounds(at)
+		assert.Require(t, assert.NoError(t, err))
+		assert.True(t, start.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+		assert.True(t, end.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))
+	})
+}
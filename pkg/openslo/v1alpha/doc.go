@@ -1,2 +1,4 @@
 // Package v1alpha defines the OpenSLO specification version v1alpha definitions.
 package v1alpha
+
+//go:generate go -C ../../../internal/cmd/objectgen run . -dir $PWD
@@ -0,0 +1,15 @@
+package v1alpha
+
+// Normalize returns a deep copy of the SLO.
+// The v1alpha specification does not define equivalent forms, so no other changes are made.
+// It is provided for consistency with the other versions.
+func (s SLO) Normalize() SLO {
+	return s.DeepCopy()
+}
+
+// Normalize returns a deep copy of the Service.
+// The v1alpha specification does not define equivalent forms, so no other changes are made.
+// It is provided for consistency with the other versions.
+func (s Service) Normalize() Service {
+	return s.DeepCopy()
+}
@@ -20,6 +20,17 @@ func GetSupportedKinds() []openslo.Kind {
 	return slices.Clone(supportedKinds)
 }
 
+var objectConstructors = map[openslo.Kind]openslo.ObjectConstructor{
+	openslo.KindSLO:     func() openslo.Object { return SLO{} },
+	openslo.KindService: func() openslo.Object { return Service{} },
+}
+
+func init() {
+	for _, kind := range supportedKinds {
+		openslo.MustRegisterObject(APIVersion, kind, objectConstructors[kind])
+	}
+}
+
 type Object interface {
 	openslo.Object
 	GetMetadata() Metadata
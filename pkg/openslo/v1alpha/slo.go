@@ -285,7 +285,10 @@ var sloTimeSlicesObjectiveValidation = govy.New(
 				Rules(rules.GTE(0.0), rules.LTE(1.0)),
 		)),
 ).
-	When(func(s SLOSpec) bool { return s.BudgetingMethod == SLOBudgetingMethodTimeslices })
+	When(
+		func(s SLOSpec) bool { return s.BudgetingMethod == SLOBudgetingMethodTimeslices },
+		govy.WhenDescription("'budgetingMethod' is 'Timeslices'"),
+	)
 
 var sloRatioMetricsValidation = govy.New(
 	govy.For(func(s SLORatioMetrics) SLOMetricSourceSpec { return s.Good }).
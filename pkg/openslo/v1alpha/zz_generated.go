@@ -0,0 +1,315 @@
+// Code generated by objectgen. DO NOT EDIT.
+
+package v1alpha
+
+// DeepCopy returns a deep copy of the Metadata.
+func (in Metadata) DeepCopy() Metadata {
+	return in
+}
+
+// Equal reports whether the Metadata is deeply equal to the other Metadata.
+// Nil and empty slices and maps are considered equal.
+func (in Metadata) Equal(other Metadata) bool {
+	if in.Name != other.Name {
+		return false
+	}
+	if in.DisplayName != other.DisplayName {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLO.
+func (in SLO) DeepCopy() SLO {
+	out := in
+	out.Spec = in.Spec.DeepCopy()
+	return out
+}
+
+// Equal reports whether the SLO is deeply equal to the other SLO.
+// Nil and empty slices and maps are considered equal.
+func (in SLO) Equal(other SLO) bool {
+	if in.APIVersion != other.APIVersion {
+		return false
+	}
+	if in.Kind != other.Kind {
+		return false
+	}
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOCalendar.
+func (in SLOCalendar) DeepCopy() SLOCalendar {
+	return in
+}
+
+// Equal reports whether the SLOCalendar is deeply equal to the other SLOCalendar.
+// Nil and empty slices and maps are considered equal.
+func (in SLOCalendar) Equal(other SLOCalendar) bool {
+	if in.StartTime != other.StartTime {
+		return false
+	}
+	if in.TimeZone != other.TimeZone {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOIndicator.
+func (in SLOIndicator) DeepCopy() SLOIndicator {
+	return in
+}
+
+// Equal reports whether the SLOIndicator is deeply equal to the other SLOIndicator.
+// Nil and empty slices and maps are considered equal.
+func (in SLOIndicator) Equal(other SLOIndicator) bool {
+	if !in.ThresholdMetric.Equal(other.ThresholdMetric) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOMetricSourceSpec.
+func (in SLOMetricSourceSpec) DeepCopy() SLOMetricSourceSpec {
+	return in
+}
+
+// Equal reports whether the SLOMetricSourceSpec is deeply equal to the other SLOMetricSourceSpec.
+// Nil and empty slices and maps are considered equal.
+func (in SLOMetricSourceSpec) Equal(other SLOMetricSourceSpec) bool {
+	if in.Source != other.Source {
+		return false
+	}
+	if in.QueryType != other.QueryType {
+		return false
+	}
+	if in.Query != other.Query {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOObjective.
+func (in SLOObjective) DeepCopy() SLOObjective {
+	out := in
+	if in.Value != nil {
+		ptr1 := *in.Value
+		out.Value = &ptr1
+	}
+	if in.RatioMetrics != nil {
+		ptr1 := (*in.RatioMetrics).DeepCopy()
+		out.RatioMetrics = &ptr1
+	}
+	if in.BudgetTarget != nil {
+		ptr1 := *in.BudgetTarget
+		out.BudgetTarget = &ptr1
+	}
+	if in.TimeSliceTarget != nil {
+		ptr1 := *in.TimeSliceTarget
+		out.TimeSliceTarget = &ptr1
+	}
+	return out
+}
+
+// Equal reports whether the SLOObjective is deeply equal to the other SLOObjective.
+// Nil and empty slices and maps are considered equal.
+func (in SLOObjective) Equal(other SLOObjective) bool {
+	if in.DisplayName != other.DisplayName {
+		return false
+	}
+	if (in.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if in.Value != nil {
+		if *in.Value != *other.Value {
+			return false
+		}
+	}
+	if (in.RatioMetrics == nil) != (other.RatioMetrics == nil) {
+		return false
+	}
+	if in.RatioMetrics != nil {
+		if !(*in.RatioMetrics).Equal(*other.RatioMetrics) {
+			return false
+		}
+	}
+	if (in.BudgetTarget == nil) != (other.BudgetTarget == nil) {
+		return false
+	}
+	if in.BudgetTarget != nil {
+		if *in.BudgetTarget != *other.BudgetTarget {
+			return false
+		}
+	}
+	if (in.TimeSliceTarget == nil) != (other.TimeSliceTarget == nil) {
+		return false
+	}
+	if in.TimeSliceTarget != nil {
+		if *in.TimeSliceTarget != *other.TimeSliceTarget {
+			return false
+		}
+	}
+	if in.Operator != other.Operator {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLORatioMetrics.
+func (in SLORatioMetrics) DeepCopy() SLORatioMetrics {
+	return in
+}
+
+// Equal reports whether the SLORatioMetrics is deeply equal to the other SLORatioMetrics.
+// Nil and empty slices and maps are considered equal.
+func (in SLORatioMetrics) Equal(other SLORatioMetrics) bool {
+	if !in.Good.Equal(other.Good) {
+		return false
+	}
+	if !in.Total.Equal(other.Total) {
+		return false
+	}
+	if in.Incremental != other.Incremental {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOSpec.
+func (in SLOSpec) DeepCopy() SLOSpec {
+	out := in
+	if in.TimeWindows != nil {
+		out.TimeWindows = make([]SLOTimeWindow, len(in.TimeWindows))
+		copy(out.TimeWindows, in.TimeWindows)
+		for i1 := range in.TimeWindows {
+			out.TimeWindows[i1] = in.TimeWindows[i1].DeepCopy()
+		}
+	}
+	if in.Indicator != nil {
+		ptr1 := (*in.Indicator).DeepCopy()
+		out.Indicator = &ptr1
+	}
+	if in.Objectives != nil {
+		out.Objectives = make([]SLOObjective, len(in.Objectives))
+		copy(out.Objectives, in.Objectives)
+		for i1 := range in.Objectives {
+			out.Objectives[i1] = in.Objectives[i1].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether the SLOSpec is deeply equal to the other SLOSpec.
+// Nil and empty slices and maps are considered equal.
+func (in SLOSpec) Equal(other SLOSpec) bool {
+	if len(in.TimeWindows) != len(other.TimeWindows) {
+		return false
+	}
+	for i1 := range in.TimeWindows {
+		if !in.TimeWindows[i1].Equal(other.TimeWindows[i1]) {
+			return false
+		}
+	}
+	if in.BudgetingMethod != other.BudgetingMethod {
+		return false
+	}
+	if in.Description != other.Description {
+		return false
+	}
+	if (in.Indicator == nil) != (other.Indicator == nil) {
+		return false
+	}
+	if in.Indicator != nil {
+		if !(*in.Indicator).Equal(*other.Indicator) {
+			return false
+		}
+	}
+	if in.Service != other.Service {
+		return false
+	}
+	if len(in.Objectives) != len(other.Objectives) {
+		return false
+	}
+	for i1 := range in.Objectives {
+		if !in.Objectives[i1].Equal(other.Objectives[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOTimeWindow.
+func (in SLOTimeWindow) DeepCopy() SLOTimeWindow {
+	out := in
+	if in.Calendar != nil {
+		ptr1 := (*in.Calendar).DeepCopy()
+		out.Calendar = &ptr1
+	}
+	return out
+}
+
+// Equal reports whether the SLOTimeWindow is deeply equal to the other SLOTimeWindow.
+// Nil and empty slices and maps are considered equal.
+func (in SLOTimeWindow) Equal(other SLOTimeWindow) bool {
+	if in.Unit != other.Unit {
+		return false
+	}
+	if in.Count != other.Count {
+		return false
+	}
+	if in.IsRolling != other.IsRolling {
+		return false
+	}
+	if (in.Calendar == nil) != (other.Calendar == nil) {
+		return false
+	}
+	if in.Calendar != nil {
+		if !(*in.Calendar).Equal(*other.Calendar) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Service.
+func (in Service) DeepCopy() Service {
+	return in
+}
+
+// Equal reports whether the Service is deeply equal to the other Service.
+// Nil and empty slices and maps are considered equal.
+func (in Service) Equal(other Service) bool {
+	if in.APIVersion != other.APIVersion {
+		return false
+	}
+	if in.Kind != other.Kind {
+		return false
+	}
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the ServiceSpec.
+func (in ServiceSpec) DeepCopy() ServiceSpec {
+	return in
+}
+
+// Equal reports whether the ServiceSpec is deeply equal to the other ServiceSpec.
+// Nil and empty slices and maps are considered equal.
+func (in ServiceSpec) Equal(other ServiceSpec) bool {
+	if in.Description != other.Description {
+		return false
+	}
+	return true
+}
@@ -127,4 +127,7 @@ var alertConditionBurnRateValidation = govy.New(
 		Required().
 		Include(durationShortHandValidation),
 ).
-	When(func(a AlertConditionType) bool { return a.Kind == AlertConditionKindBurnRate })
+	When(
+		func(a AlertConditionType) bool { return a.Kind == AlertConditionKindBurnRate },
+		govy.WhenDescription("'kind' is 'burnrate'"),
+	)
@@ -2,3 +2,5 @@
 // It is a prototype of the next version of the OpenSLO specification.
 // It is not stable and is subject to breaking changes.
 package v2alpha
+
+//go:generate go -C ../../../internal/cmd/objectgen run . -dir $PWD
@@ -0,0 +1,156 @@
+package v2alpha
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/OpenSLO/go-sdk/internal"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/metricsource"
+)
+
+// Normalize returns a deep copy of the SLO with equivalent forms converted to a single, canonical one:
+//   - [SLOObjective.TargetPercent] is converted to [SLOObjective.Target]
+//   - [Metadata] is normalized, see [Metadata.Normalize]
+//   - inlined objects are normalized the same way their standalone counterparts are
+func (s SLO) Normalize() SLO {
+	s = s.DeepCopy()
+	s.Metadata = s.Metadata.Normalize()
+	if s.Spec.SLI != nil {
+		s.Spec.SLI.Metadata = s.Spec.SLI.Metadata.Normalize()
+		s.Spec.SLI.Spec = normalizeSLISpec(s.Spec.SLI.Spec)
+	}
+	for i := range s.Spec.Objectives {
+		s.Spec.Objectives[i] = normalizeSLOObjective(s.Spec.Objectives[i])
+	}
+	for i := range s.Spec.AlertPolicies {
+		if s.Spec.AlertPolicies[i].SLOAlertPolicyInline != nil {
+			s.Spec.AlertPolicies[i].Metadata = s.Spec.AlertPolicies[i].Metadata.Normalize()
+			s.Spec.AlertPolicies[i].Spec = normalizeAlertPolicySpec(s.Spec.AlertPolicies[i].Spec)
+		}
+	}
+	return s
+}
+
+// Normalize returns a deep copy of the SLI with equivalent forms converted to a single, canonical one:
+//   - inlined [DataSourceSpec] is normalized the same way [DataSource.Normalize] does it
+//   - [Metadata] is normalized, see [Metadata.Normalize]
+func (s SLI) Normalize() SLI {
+	s = s.DeepCopy()
+	s.Metadata = s.Metadata.Normalize()
+	s.Spec = normalizeSLISpec(s.Spec)
+	return s
+}
+
+// Normalize returns a deep copy of the DataSource with equivalent forms converted to a single, canonical one:
+//   - [DataSourceSpec.Type] of supported metric sources is spelled as defined by [metricsource.Type]
+//   - [DataSourceSpec.ConnectionDetails] are compacted, insignificant whitespace is removed
+//   - [Metadata] is normalized, see [Metadata.Normalize]
+func (d DataSource) Normalize() DataSource {
+	d = d.DeepCopy()
+	d.Metadata = d.Metadata.Normalize()
+	d.Spec = normalizeDataSourceSpec(d.Spec)
+	return d
+}
+
+// Normalize returns a deep copy of the Service with its [Metadata] normalized, see [Metadata.Normalize].
+func (s Service) Normalize() Service {
+	s = s.DeepCopy()
+	s.Metadata = s.Metadata.Normalize()
+	return s
+}
+
+// Normalize returns a deep copy of the AlertPolicy with its [Metadata] normalized, see [Metadata.Normalize].
+// Inlined objects are normalized the same way their standalone counterparts are.
+func (a AlertPolicy) Normalize() AlertPolicy {
+	a = a.DeepCopy()
+	a.Metadata = a.Metadata.Normalize()
+	a.Spec = normalizeAlertPolicySpec(a.Spec)
+	return a
+}
+
+// Normalize returns a deep copy of the AlertCondition with its [Metadata] normalized, see [Metadata.Normalize].
+func (a AlertCondition) Normalize() AlertCondition {
+	a = a.DeepCopy()
+	a.Metadata = a.Metadata.Normalize()
+	return a
+}
+
+// Normalize returns a deep copy of the AlertNotificationTarget with its [Metadata] normalized,
+// see [Metadata.Normalize].
+func (a AlertNotificationTarget) Normalize() AlertNotificationTarget {
+	a = a.DeepCopy()
+	a.Metadata = a.Metadata.Normalize()
+	return a
+}
+
+// Normalize returns a deep copy of the Metadata with empty [Labels] and [Annotations] set to nil.
+func (m Metadata) Normalize() Metadata {
+	m = m.DeepCopy()
+	if len(m.Labels) == 0 {
+		m.Labels = nil
+	}
+	if len(m.Annotations) == 0 {
+		m.Annotations = nil
+	}
+	return m
+}
+
+// normalizeSLOObjective converts TargetPercent to Target.
+// If both are set, the objective is invalid and is left as is.
+func normalizeSLOObjective(objective SLOObjective) SLOObjective {
+	if objective.TargetPercent != nil && objective.Target == nil {
+		target := internal.PercentToRatio(*objective.TargetPercent)
+		objective.Target = &target
+		objective.TargetPercent = nil
+	}
+	if objective.SLI != nil {
+		objective.SLI.Metadata = objective.SLI.Metadata.Normalize()
+		objective.SLI.Spec = normalizeSLISpec(objective.SLI.Spec)
+	}
+	return objective
+}
+
+func normalizeSLISpec(spec SLISpec) SLISpec {
+	metricSpecs := []*SLIMetricSpec{spec.ThresholdMetric}
+	if spec.RatioMetric != nil {
+		metricSpecs = append(metricSpecs,
+			spec.RatioMetric.Good,
+			spec.RatioMetric.Bad,
+			spec.RatioMetric.Total,
+			spec.RatioMetric.Raw,
+		)
+	}
+	for _, metricSpec := range metricSpecs {
+		if metricSpec != nil && metricSpec.DataSourceSpec != nil {
+			*metricSpec.DataSourceSpec = normalizeDataSourceSpec(*metricSpec.DataSourceSpec)
+		}
+	}
+	return spec
+}
+
+func normalizeDataSourceSpec(spec DataSourceSpec) DataSourceSpec {
+	if typ, ok := metricsource.ParseType(spec.Type); ok {
+		spec.Type = string(typ)
+	}
+	if len(spec.ConnectionDetails) > 0 {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, spec.ConnectionDetails); err == nil {
+			spec.ConnectionDetails = buf.Bytes()
+		}
+	}
+	return spec
+}
+
+func normalizeAlertPolicySpec(spec AlertPolicySpec) AlertPolicySpec {
+	for i := range spec.Conditions {
+		if spec.Conditions[i].AlertPolicyConditionInline != nil {
+			spec.Conditions[i].Metadata = spec.Conditions[i].Metadata.Normalize()
+		}
+	}
+	for i := range spec.NotificationTargets {
+		if spec.NotificationTargets[i].AlertPolicyNotificationTargetInline != nil {
+			spec.NotificationTargets[i].Metadata = spec.NotificationTargets[i].Metadata.Normalize()
+		}
+	}
+	return spec
+}
@@ -0,0 +1,91 @@
+package v2alpha
+
+import (
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+)
+
+func TestSLO_DeepCopy(t *testing.T) {
+	slo := validRatioSLOWithInlinedAlertPolicy()
+	slo.Spec.SLI.Spec.RatioMetric.Good.DataSourceSpec = &DataSourceSpec{
+		Type:              "Prometheus",
+		ConnectionDetails: []byte(`{"url":"http://prometheus.example.com"}`),
+	}
+
+	copied := slo.DeepCopy()
+	assert.True(t, copied.Equal(slo))
+
+	copied.Metadata.Labels["team"] = "team-c"
+	copied.Spec.SLI.Spec.RatioMetric.Good.DataSourceSpec.ConnectionDetails[0] = '['
+	copied.Spec.SLI.Spec.RatioMetric.Total.Spec["query"] = "up"
+	*copied.Spec.Objectives[0].Target = 0.9
+	copied.Spec.AlertPolicies[0].Spec.Conditions[0].ConditionRef = "copied"
+
+	assert.False(t, copied.Equal(slo))
+	assert.Equal(t, "team-a", slo.Metadata.Labels["team"])
+	assert.Equal(t, byte('{'), slo.Spec.SLI.Spec.RatioMetric.Good.DataSourceSpec.ConnectionDetails[0])
+	assert.True(t, slo.Spec.AlertPolicies[0].Equal(validRatioSLOWithInlinedAlertPolicy().Spec.AlertPolicies[0]))
+	assert.Equal(t, 0.995, *slo.Spec.Objectives[0].Target)
+}
+
+func TestSLO_Normalize(t *testing.T) {
+	slo := validRatioSLO()
+	slo.Metadata.Annotations = Annotations{}
+	slo.Spec.Objectives[0].Target = nil
+	slo.Spec.Objectives[0].TargetPercent = ptr(99.5)
+	slo.Spec.SLI.Spec.RatioMetric.Good.DataSourceRef = ""
+	slo.Spec.SLI.Spec.RatioMetric.Good.DataSourceSpec = &DataSourceSpec{
+		Type:              "prometheus",
+		ConnectionDetails: []byte(`{ "url": "http://prometheus.example.com" }`),
+	}
+
+	normalized := slo.Normalize()
+
+	expected := validRatioSLO()
+	expected.Spec.SLI.Spec.RatioMetric.Good.DataSourceRef = ""
+	expected.Spec.SLI.Spec.RatioMetric.Good.DataSourceSpec = &DataSourceSpec{
+		Type:              "Prometheus",
+		ConnectionDetails: []byte(`{"url":"http://prometheus.example.com"}`),
+	}
+	assert.Equal(t, expected, normalized)
+	// The original object is not modified.
+	assert.Equal(t, "prometheus", slo.Spec.SLI.Spec.RatioMetric.Good.DataSourceSpec.Type)
+	assert.Equal(t, 99.5, *slo.Spec.Objectives[0].TargetPercent)
+}
+
+func TestSLO_Normalize_TargetPercent(t *testing.T) {
+	for _, tc := range []struct {
+		targetPercent float64
+		target        float64
+	}{
+		{targetPercent: 99, target: 0.99},
+		{targetPercent: 99.9, target: 0.999},
+		{targetPercent: 99.99, target: 0.9999},
+	} {
+		slo := validRatioSLO()
+		slo.Spec.Objectives[0].Target = nil
+		slo.Spec.Objectives[0].TargetPercent = ptr(tc.targetPercent)
+		expected := validRatioSLO()
+		expected.Spec.Objectives[0].Target = ptr(tc.target)
+
+		normalized := slo.Normalize()
+
+		assert.Equal(t, tc.target, *normalized.Spec.Objectives[0].Target)
+		assert.True(t, normalized.Equal(expected.Normalize()))
+	}
+}
+
+func TestDataSource_Normalize(t *testing.T) {
+	dataSource := validDataSource()
+	dataSource.Metadata.Annotations = Annotations{}
+	dataSource.Spec.ConnectionDetails = []byte(`[ {"url": "http://prometheus.example.com"} ]`)
+
+	normalized := dataSource.Normalize()
+
+	expected := validDataSource()
+	expected.Metadata.Annotations = nil
+	assert.Equal(t, expected, normalized)
+	assert.False(t, dataSource.Equal(expected))
+	assert.True(t, normalized.Equal(expected))
+}
@@ -26,6 +26,22 @@ func GetSupportedKinds() []openslo.Kind {
 	return slices.Clone(supportedKinds)
 }
 
+var objectConstructors = map[openslo.Kind]openslo.ObjectConstructor{
+	openslo.KindSLO:                     func() openslo.Object { return SLO{} },
+	openslo.KindSLI:                     func() openslo.Object { return SLI{} },
+	openslo.KindDataSource:              func() openslo.Object { return DataSource{} },
+	openslo.KindService:                 func() openslo.Object { return Service{} },
+	openslo.KindAlertPolicy:             func() openslo.Object { return AlertPolicy{} },
+	openslo.KindAlertCondition:          func() openslo.Object { return AlertCondition{} },
+	openslo.KindAlertNotificationTarget: func() openslo.Object { return AlertNotificationTarget{} },
+}
+
+func init() {
+	for _, kind := range supportedKinds {
+		openslo.MustRegisterObject(APIVersion, kind, objectConstructors[kind])
+	}
+}
+
 type Object interface {
 	openslo.Object
 	GetMetadata() Metadata
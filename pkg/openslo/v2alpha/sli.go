@@ -6,6 +6,7 @@ import (
 
 	"github.com/OpenSLO/go-sdk/internal"
 	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/metricsource"
 )
 
 var (
@@ -145,16 +146,25 @@ var sliFractionMetricValidation = govy.New(
 	govy.ForPointer(func(m SLIRatioMetric) *SLIMetricSpec { return m.Good }).
 		WithName("good").
 		Cascade(govy.CascadeModeContinue).
-		When(func(m SLIRatioMetric) bool { return m.Good != nil }).
+		When(
+			func(m SLIRatioMetric) bool { return m.Good != nil },
+			govy.WhenDescription("'good' is set"),
+		).
 		Include(sliMetricSpecValidation),
 	govy.ForPointer(func(m SLIRatioMetric) *SLIMetricSpec { return m.Bad }).
 		WithName("bad").
 		Cascade(govy.CascadeModeContinue).
-		When(func(m SLIRatioMetric) bool { return m.Bad != nil }).
+		When(
+			func(m SLIRatioMetric) bool { return m.Bad != nil },
+			govy.WhenDescription("'bad' is set"),
+		).
 		Include(sliMetricSpecValidation),
 ).
 	Cascade(govy.CascadeModeStop).
-	When(func(m SLIRatioMetric) bool { return m.Total != nil })
+	When(
+		func(m SLIRatioMetric) bool { return m.Total != nil },
+		govy.WhenDescription("'total' is set"),
+	)
 
 var sliRawMetricSpecValidation = govy.New(
 	govy.ForPointer(func(m SLIRatioMetric) *SLIMetricSpec { return m.Raw }).
@@ -165,7 +175,10 @@ var sliRawMetricSpecValidation = govy.New(
 		Required().
 		Rules(rules.OneOf(validSLIRawMetricTypes...)),
 ).
-	When(func(m SLIRatioMetric) bool { return m.Raw != nil })
+	When(
+		func(m SLIRatioMetric) bool { return m.Raw != nil },
+		govy.WhenDescription("'raw' is set"),
+	)
 
 var sliMetricSpecValidation = govy.New(
 	govy.For(govy.GetSelf[SLIMetricSpec]()).
@@ -180,4 +193,14 @@ var sliMetricSpecValidation = govy.New(
 	govy.ForPointer(func(spec SLIMetricSpec) *DataSourceSpec { return spec.DataSourceSpec }).
 		WithName("dataSourceSpec").
 		Include(dataSourceSpecValidation),
+	govy.For(govy.GetSelf[SLIMetricSpec]()).
+		Include(metricsource.NewValidator(
+			func(spec SLIMetricSpec) string {
+				if spec.DataSourceSpec == nil {
+					return ""
+				}
+				return spec.DataSourceSpec.Type
+			},
+			func(spec SLIMetricSpec) map[string]any { return spec.Spec },
+		)),
 )
@@ -258,6 +258,7 @@ func runSLIMetricSpecTests[T openslo.Object](t *testing.T, path string, objectGe
 				Type:              "Datadog",
 				ConnectionDetails: json.RawMessage(`{"secretKey":"secret"}`),
 			},
+			Spec: map[string]any{"query": "query"},
 		})
 		err := object.Validate()
 		govytest.AssertError(t, err, govytest.ExpectedRuleError{
@@ -281,9 +282,28 @@ func runSLIMetricSpecTests[T openslo.Object](t *testing.T, path string, objectGe
 		runDataSourceSpecTests(t, path+".dataSourceSpec", func(spec DataSourceSpec) T {
 			return objectGetter(SLIMetricSpec{
 				DataSourceSpec: &spec,
+				Spec:           map[string]any{"query": "query"},
 			})
 		})
 	})
+	t.Run("typed spec", func(t *testing.T) {
+		metricSpec := SLIMetricSpec{
+			DataSourceSpec: &DataSourceSpec{
+				Type:              "prometheus",
+				ConnectionDetails: json.RawMessage(`{"url":"http://prometheus.example.com"}`),
+			},
+			Spec: map[string]any{"step": 60},
+		}
+		err := objectGetter(metricSpec).Validate()
+		govytest.AssertError(t, err, govytest.ExpectedRuleError{
+			PropertyPath: path + ".spec.query",
+			Code:         rules.ErrorCodeRequired,
+		})
+
+		metricSpec.DataSourceSpec.Type = "Custom"
+		err = objectGetter(metricSpec).Validate()
+		govytest.AssertNoError(t, err)
+	})
 }
 
 func validSLI() SLI {
@@ -122,6 +122,24 @@ type SLOTimeWindow struct {
 	Calendar  *SLOCalendar      `json:"calendar,omitempty"`
 }
 
+// Bounds returns the start (inclusive) and end (exclusive) of the budgeting window which contains t.
+// Rolling windows end at t, while calendar-aligned windows are anchored at [SLOCalendar.StartTime]
+// and their bounds are returned in [SLOCalendar.TimeZone].
+// Days and weeks follow the calendar in the relevant time zone,
+// which means they respect daylight saving time changes.
+func (s SLOTimeWindow) Bounds(t time.Time) (start, end time.Time, err error) {
+	w := internal.TimeWindow{
+		Unit:      string(s.Duration.GetUnit()),
+		Value:     s.Duration.GetValue(),
+		IsRolling: s.IsRolling,
+	}
+	if s.Calendar != nil {
+		w.StartTime = s.Calendar.StartTime
+		w.TimeZone = s.Calendar.TimeZone
+	}
+	return w.Bounds(t)
+}
+
 type SLOCalendar struct {
 	StartTime string `json:"startTime"`
 	TimeZone  string `json:"timeZone"`
@@ -227,7 +245,10 @@ func getSLOSLIValidation[T any](
 	).
 		// Another validation rule on 'spec' level already checks a scenario
 		// in which neither 'sli' nor 'sliRef' are provided.
-		When(func(t T) bool { return sliGetter(t) != nil || sliRefGetter(t) != nil }).
+		When(
+			func(t T) bool { return sliGetter(t) != nil || sliRefGetter(t) != nil },
+			govy.WhenDescription("'sli' or 'sliRef' is set"),
+		).
 		Cascade(govy.CascadeModeStop)
 }
 
@@ -353,7 +374,10 @@ var sloTimeSlicesObjectiveValidation = govy.New(
 			validationRulesForTimeSliceWindow(),
 		)),
 ).
-	When(func(s SLOSpec) bool { return s.BudgetingMethod == SLOBudgetingMethodTimeslices })
+	When(
+		func(s SLOSpec) bool { return s.BudgetingMethod == SLOBudgetingMethodTimeslices },
+		govy.WhenDescription("'budgetingMethod' is 'Timeslices'"),
+	)
 
 var sloRatioTimeSlicesObjectiveValidation = govy.New(
 	govy.ForSlice(func(spec SLOSpec) []SLOObjective { return spec.Objectives }).
@@ -362,7 +386,10 @@ var sloRatioTimeSlicesObjectiveValidation = govy.New(
 			validationRulesForTimeSliceWindow(),
 		)),
 ).
-	When(func(s SLOSpec) bool { return s.BudgetingMethod == SLOBudgetingMethodRatioTimeslices })
+	When(
+		func(s SLOSpec) bool { return s.BudgetingMethod == SLOBudgetingMethodRatioTimeslices },
+		govy.WhenDescription("'budgetingMethod' is 'RatioTimeslices'"),
+	)
 
 func validationRulesForTimeSliceWindow() govy.PropertyRules[DurationShorthand, SLOObjective] {
 	return govy.ForPointer(func(s SLOObjective) *DurationShorthand { return s.TimeSliceWindow }).
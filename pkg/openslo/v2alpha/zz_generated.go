@@ -0,0 +1,1102 @@
+// Code generated by objectgen. DO NOT EDIT.
+
+package v2alpha
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/OpenSLO/go-sdk/internal"
+)
+
+// DeepCopy returns a deep copy of the AlertCondition.
+func (in AlertCondition) DeepCopy() AlertCondition {
+	out := in
+	out.Metadata = in.Metadata.DeepCopy()
+	out.Spec = in.Spec.DeepCopy()
+	return out
+}
+
+// Equal reports whether the AlertCondition is deeply equal to the other AlertCondition.
+// Nil and empty slices and maps are considered equal.
+func (in AlertCondition) Equal(other AlertCondition) bool {
+	if in.APIVersion != other.APIVersion {
+		return false
+	}
+	if in.Kind != other.Kind {
+		return false
+	}
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AlertConditionSpec.
+func (in AlertConditionSpec) DeepCopy() AlertConditionSpec {
+	out := in
+	out.Condition = in.Condition.DeepCopy()
+	return out
+}
+
+// Equal reports whether the AlertConditionSpec is deeply equal to the other AlertConditionSpec.
+// Nil and empty slices and maps are considered equal.
+func (in AlertConditionSpec) Equal(other AlertConditionSpec) bool {
+	if in.Severity != other.Severity {
+		return false
+	}
+	if !in.Condition.Equal(other.Condition) {
+		return false
+	}
+	if in.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AlertConditionType.
+func (in AlertConditionType) DeepCopy() AlertConditionType {
+	out := in
+	if in.Threshold != nil {
+		ptr1 := *in.Threshold
+		out.Threshold = &ptr1
+	}
+	return out
+}
+
+// Equal reports whether the AlertConditionType is deeply equal to the other AlertConditionType.
+// Nil and empty slices and maps are considered equal.
+func (in AlertConditionType) Equal(other AlertConditionType) bool {
+	if in.Kind != other.Kind {
+		return false
+	}
+	if in.Operator != other.Operator {
+		return false
+	}
+	if (in.Threshold == nil) != (other.Threshold == nil) {
+		return false
+	}
+	if in.Threshold != nil {
+		if *in.Threshold != *other.Threshold {
+			return false
+		}
+	}
+	if !in.LookbackWindow.Equal(other.LookbackWindow) {
+		return false
+	}
+	if !in.AlertAfter.Equal(other.AlertAfter) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AlertNotificationTarget.
+func (in AlertNotificationTarget) DeepCopy() AlertNotificationTarget {
+	out := in
+	out.Metadata = in.Metadata.DeepCopy()
+	return out
+}
+
+// Equal reports whether the AlertNotificationTarget is deeply equal to the other AlertNotificationTarget.
+// Nil and empty slices and maps are considered equal.
+func (in AlertNotificationTarget) Equal(other AlertNotificationTarget) bool {
+	if in.APIVersion != other.APIVersion {
+		return false
+	}
+	if in.Kind != other.Kind {
+		return false
+	}
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AlertNotificationTargetSpec.
+func (in AlertNotificationTargetSpec) DeepCopy() AlertNotificationTargetSpec {
+	return in
+}
+
+// Equal reports whether the AlertNotificationTargetSpec is deeply equal to the other AlertNotificationTargetSpec.
+// Nil and empty slices and maps are considered equal.
+func (in AlertNotificationTargetSpec) Equal(other AlertNotificationTargetSpec) bool {
+	if in.Description != other.Description {
+		return false
+	}
+	if in.Target != other.Target {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AlertPolicy.
+func (in AlertPolicy) DeepCopy() AlertPolicy {
+	out := in
+	out.Metadata = in.Metadata.DeepCopy()
+	out.Spec = in.Spec.DeepCopy()
+	return out
+}
+
+// Equal reports whether the AlertPolicy is deeply equal to the other AlertPolicy.
+// Nil and empty slices and maps are considered equal.
+func (in AlertPolicy) Equal(other AlertPolicy) bool {
+	if in.APIVersion != other.APIVersion {
+		return false
+	}
+	if in.Kind != other.Kind {
+		return false
+	}
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AlertPolicyCondition.
+func (in AlertPolicyCondition) DeepCopy() AlertPolicyCondition {
+	out := in
+	if in.AlertPolicyConditionRef != nil {
+		ptr1 := (*in.AlertPolicyConditionRef).DeepCopy()
+		out.AlertPolicyConditionRef = &ptr1
+	}
+	if in.AlertPolicyConditionInline != nil {
+		ptr1 := (*in.AlertPolicyConditionInline).DeepCopy()
+		out.AlertPolicyConditionInline = &ptr1
+	}
+	return out
+}
+
+// Equal reports whether the AlertPolicyCondition is deeply equal to the other AlertPolicyCondition.
+// Nil and empty slices and maps are considered equal.
+func (in AlertPolicyCondition) Equal(other AlertPolicyCondition) bool {
+	if (in.AlertPolicyConditionRef == nil) != (other.AlertPolicyConditionRef == nil) {
+		return false
+	}
+	if in.AlertPolicyConditionRef != nil {
+		if !(*in.AlertPolicyConditionRef).Equal(*other.AlertPolicyConditionRef) {
+			return false
+		}
+	}
+	if (in.AlertPolicyConditionInline == nil) != (other.AlertPolicyConditionInline == nil) {
+		return false
+	}
+	if in.AlertPolicyConditionInline != nil {
+		if !(*in.AlertPolicyConditionInline).Equal(*other.AlertPolicyConditionInline) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AlertPolicyConditionInline.
+func (in AlertPolicyConditionInline) DeepCopy() AlertPolicyConditionInline {
+	out := in
+	out.Metadata = in.Metadata.DeepCopy()
+	out.Spec = in.Spec.DeepCopy()
+	return out
+}
+
+// Equal reports whether the AlertPolicyConditionInline is deeply equal to the other AlertPolicyConditionInline.
+// Nil and empty slices and maps are considered equal.
+func (in AlertPolicyConditionInline) Equal(other AlertPolicyConditionInline) bool {
+	if in.Kind != other.Kind {
+		return false
+	}
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AlertPolicyConditionRef.
+func (in AlertPolicyConditionRef) DeepCopy() AlertPolicyConditionRef {
+	return in
+}
+
+// Equal reports whether the AlertPolicyConditionRef is deeply equal to the other AlertPolicyConditionRef.
+// Nil and empty slices and maps are considered equal.
+func (in AlertPolicyConditionRef) Equal(other AlertPolicyConditionRef) bool {
+	if in.ConditionRef != other.ConditionRef {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AlertPolicyNotificationTarget.
+func (in AlertPolicyNotificationTarget) DeepCopy() AlertPolicyNotificationTarget {
+	out := in
+	if in.AlertPolicyNotificationTargetRef != nil {
+		ptr1 := (*in.AlertPolicyNotificationTargetRef).DeepCopy()
+		out.AlertPolicyNotificationTargetRef = &ptr1
+	}
+	if in.AlertPolicyNotificationTargetInline != nil {
+		ptr1 := (*in.AlertPolicyNotificationTargetInline).DeepCopy()
+		out.AlertPolicyNotificationTargetInline = &ptr1
+	}
+	return out
+}
+
+// Equal reports whether the AlertPolicyNotificationTarget is deeply equal to the other AlertPolicyNotificationTarget.
+// Nil and empty slices and maps are considered equal.
+func (in AlertPolicyNotificationTarget) Equal(other AlertPolicyNotificationTarget) bool {
+	if (in.AlertPolicyNotificationTargetRef == nil) != (other.AlertPolicyNotificationTargetRef == nil) {
+		return false
+	}
+	if in.AlertPolicyNotificationTargetRef != nil {
+		if !(*in.AlertPolicyNotificationTargetRef).Equal(*other.AlertPolicyNotificationTargetRef) {
+			return false
+		}
+	}
+	if (in.AlertPolicyNotificationTargetInline == nil) != (other.AlertPolicyNotificationTargetInline == nil) {
+		return false
+	}
+	if in.AlertPolicyNotificationTargetInline != nil {
+		if !(*in.AlertPolicyNotificationTargetInline).Equal(*other.AlertPolicyNotificationTargetInline) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AlertPolicyNotificationTargetInline.
+func (in AlertPolicyNotificationTargetInline) DeepCopy() AlertPolicyNotificationTargetInline {
+	out := in
+	out.Metadata = in.Metadata.DeepCopy()
+	return out
+}
+
+// Equal reports whether the AlertPolicyNotificationTargetInline is deeply equal to the other AlertPolicyNotificationTargetInline.
+// Nil and empty slices and maps are considered equal.
+func (in AlertPolicyNotificationTargetInline) Equal(other AlertPolicyNotificationTargetInline) bool {
+	if in.Kind != other.Kind {
+		return false
+	}
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AlertPolicyNotificationTargetRef.
+func (in AlertPolicyNotificationTargetRef) DeepCopy() AlertPolicyNotificationTargetRef {
+	return in
+}
+
+// Equal reports whether the AlertPolicyNotificationTargetRef is deeply equal to the other AlertPolicyNotificationTargetRef.
+// Nil and empty slices and maps are considered equal.
+func (in AlertPolicyNotificationTargetRef) Equal(other AlertPolicyNotificationTargetRef) bool {
+	if in.TargetRef != other.TargetRef {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AlertPolicySpec.
+func (in AlertPolicySpec) DeepCopy() AlertPolicySpec {
+	out := in
+	if in.Conditions != nil {
+		out.Conditions = make([]AlertPolicyCondition, len(in.Conditions))
+		copy(out.Conditions, in.Conditions)
+		for i1 := range in.Conditions {
+			out.Conditions[i1] = in.Conditions[i1].DeepCopy()
+		}
+	}
+	if in.NotificationTargets != nil {
+		out.NotificationTargets = make([]AlertPolicyNotificationTarget, len(in.NotificationTargets))
+		copy(out.NotificationTargets, in.NotificationTargets)
+		for i1 := range in.NotificationTargets {
+			out.NotificationTargets[i1] = in.NotificationTargets[i1].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether the AlertPolicySpec is deeply equal to the other AlertPolicySpec.
+// Nil and empty slices and maps are considered equal.
+func (in AlertPolicySpec) Equal(other AlertPolicySpec) bool {
+	if in.Description != other.Description {
+		return false
+	}
+	if in.AlertWhenNoData != other.AlertWhenNoData {
+		return false
+	}
+	if in.AlertWhenBreaching != other.AlertWhenBreaching {
+		return false
+	}
+	if in.AlertWhenResolved != other.AlertWhenResolved {
+		return false
+	}
+	if len(in.Conditions) != len(other.Conditions) {
+		return false
+	}
+	for i1 := range in.Conditions {
+		if !in.Conditions[i1].Equal(other.Conditions[i1]) {
+			return false
+		}
+	}
+	if len(in.NotificationTargets) != len(other.NotificationTargets) {
+		return false
+	}
+	for i1 := range in.NotificationTargets {
+		if !in.NotificationTargets[i1].Equal(other.NotificationTargets[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Annotations.
+func (in Annotations) DeepCopy() Annotations {
+	out := in
+	if in != nil {
+		out = make(map[string]string, len(in))
+		for key1, val1 := range in {
+			out[key1] = val1
+		}
+	}
+	return out
+}
+
+// Equal reports whether the Annotations is deeply equal to the other Annotations.
+// Nil and empty slices and maps are considered equal.
+func (in Annotations) Equal(other Annotations) bool {
+	if len(in) != len(other) {
+		return false
+	}
+	for key1, a1 := range in {
+		b1, ok := other[key1]
+		if !ok {
+			return false
+		}
+		if a1 != b1 {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the DataSource.
+func (in DataSource) DeepCopy() DataSource {
+	out := in
+	out.Metadata = in.Metadata.DeepCopy()
+	out.Spec = in.Spec.DeepCopy()
+	return out
+}
+
+// Equal reports whether the DataSource is deeply equal to the other DataSource.
+// Nil and empty slices and maps are considered equal.
+func (in DataSource) Equal(other DataSource) bool {
+	if in.APIVersion != other.APIVersion {
+		return false
+	}
+	if in.Kind != other.Kind {
+		return false
+	}
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the DataSourceSpec.
+func (in DataSourceSpec) DeepCopy() DataSourceSpec {
+	out := in
+	if in.ConnectionDetails != nil {
+		out.ConnectionDetails = make(json.RawMessage, len(in.ConnectionDetails))
+		copy(out.ConnectionDetails, in.ConnectionDetails)
+	}
+	return out
+}
+
+// Equal reports whether the DataSourceSpec is deeply equal to the other DataSourceSpec.
+// Nil and empty slices and maps are considered equal.
+func (in DataSourceSpec) Equal(other DataSourceSpec) bool {
+	if in.Description != other.Description {
+		return false
+	}
+	if in.Type != other.Type {
+		return false
+	}
+	if len(in.ConnectionDetails) != len(other.ConnectionDetails) {
+		return false
+	}
+	for i1 := range in.ConnectionDetails {
+		if in.ConnectionDetails[i1] != other.ConnectionDetails[i1] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the DurationShorthand.
+func (in DurationShorthand) DeepCopy() DurationShorthand {
+	return in
+}
+
+// Equal reports whether the DurationShorthand is deeply equal to the other DurationShorthand.
+// Nil and empty slices and maps are considered equal.
+func (in DurationShorthand) Equal(other DurationShorthand) bool {
+	if in.unit != other.unit {
+		return false
+	}
+	if in.value != other.value {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Labels.
+func (in Labels) DeepCopy() Labels {
+	out := in
+	if in != nil {
+		out = make(map[string]string, len(in))
+		for key1, val1 := range in {
+			out[key1] = val1
+		}
+	}
+	return out
+}
+
+// Equal reports whether the Labels is deeply equal to the other Labels.
+// Nil and empty slices and maps are considered equal.
+func (in Labels) Equal(other Labels) bool {
+	if len(in) != len(other) {
+		return false
+	}
+	for key1, a1 := range in {
+		b1, ok := other[key1]
+		if !ok {
+			return false
+		}
+		if a1 != b1 {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Metadata.
+func (in Metadata) DeepCopy() Metadata {
+	out := in
+	out.Labels = in.Labels.DeepCopy()
+	out.Annotations = in.Annotations.DeepCopy()
+	return out
+}
+
+// Equal reports whether the Metadata is deeply equal to the other Metadata.
+// Nil and empty slices and maps are considered equal.
+func (in Metadata) Equal(other Metadata) bool {
+	if in.Name != other.Name {
+		return false
+	}
+	if !in.Labels.Equal(other.Labels) {
+		return false
+	}
+	if !in.Annotations.Equal(other.Annotations) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLI.
+func (in SLI) DeepCopy() SLI {
+	out := in
+	out.Metadata = in.Metadata.DeepCopy()
+	out.Spec = in.Spec.DeepCopy()
+	return out
+}
+
+// Equal reports whether the SLI is deeply equal to the other SLI.
+// Nil and empty slices and maps are considered equal.
+func (in SLI) Equal(other SLI) bool {
+	if in.APIVersion != other.APIVersion {
+		return false
+	}
+	if in.Kind != other.Kind {
+		return false
+	}
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLIMetricSpec.
+func (in SLIMetricSpec) DeepCopy() SLIMetricSpec {
+	out := in
+	if in.DataSourceSpec != nil {
+		ptr1 := (*in.DataSourceSpec).DeepCopy()
+		out.DataSourceSpec = &ptr1
+	}
+	if in.Spec != nil {
+		out.Spec = make(map[string]any, len(in.Spec))
+		for key1, val1 := range in.Spec {
+			out.Spec[key1] = internal.DeepCopyAny(val1)
+		}
+	}
+	return out
+}
+
+// Equal reports whether the SLIMetricSpec is deeply equal to the other SLIMetricSpec.
+// Nil and empty slices and maps are considered equal.
+func (in SLIMetricSpec) Equal(other SLIMetricSpec) bool {
+	if in.DataSourceRef != other.DataSourceRef {
+		return false
+	}
+	if (in.DataSourceSpec == nil) != (other.DataSourceSpec == nil) {
+		return false
+	}
+	if in.DataSourceSpec != nil {
+		if !(*in.DataSourceSpec).Equal(*other.DataSourceSpec) {
+			return false
+		}
+	}
+	if len(in.Spec) != len(other.Spec) {
+		return false
+	}
+	for key1, a1 := range in.Spec {
+		b1, ok := other.Spec[key1]
+		if !ok {
+			return false
+		}
+		if !reflect.DeepEqual(a1, b1) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLIRatioMetric.
+func (in SLIRatioMetric) DeepCopy() SLIRatioMetric {
+	out := in
+	if in.Good != nil {
+		ptr1 := (*in.Good).DeepCopy()
+		out.Good = &ptr1
+	}
+	if in.Bad != nil {
+		ptr1 := (*in.Bad).DeepCopy()
+		out.Bad = &ptr1
+	}
+	if in.Total != nil {
+		ptr1 := (*in.Total).DeepCopy()
+		out.Total = &ptr1
+	}
+	if in.Raw != nil {
+		ptr1 := (*in.Raw).DeepCopy()
+		out.Raw = &ptr1
+	}
+	return out
+}
+
+// Equal reports whether the SLIRatioMetric is deeply equal to the other SLIRatioMetric.
+// Nil and empty slices and maps are considered equal.
+func (in SLIRatioMetric) Equal(other SLIRatioMetric) bool {
+	if in.Counter != other.Counter {
+		return false
+	}
+	if (in.Good == nil) != (other.Good == nil) {
+		return false
+	}
+	if in.Good != nil {
+		if !(*in.Good).Equal(*other.Good) {
+			return false
+		}
+	}
+	if (in.Bad == nil) != (other.Bad == nil) {
+		return false
+	}
+	if in.Bad != nil {
+		if !(*in.Bad).Equal(*other.Bad) {
+			return false
+		}
+	}
+	if (in.Total == nil) != (other.Total == nil) {
+		return false
+	}
+	if in.Total != nil {
+		if !(*in.Total).Equal(*other.Total) {
+			return false
+		}
+	}
+	if in.RawType != other.RawType {
+		return false
+	}
+	if (in.Raw == nil) != (other.Raw == nil) {
+		return false
+	}
+	if in.Raw != nil {
+		if !(*in.Raw).Equal(*other.Raw) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLISpec.
+func (in SLISpec) DeepCopy() SLISpec {
+	out := in
+	if in.ThresholdMetric != nil {
+		ptr1 := (*in.ThresholdMetric).DeepCopy()
+		out.ThresholdMetric = &ptr1
+	}
+	if in.RatioMetric != nil {
+		ptr1 := (*in.RatioMetric).DeepCopy()
+		out.RatioMetric = &ptr1
+	}
+	return out
+}
+
+// Equal reports whether the SLISpec is deeply equal to the other SLISpec.
+// Nil and empty slices and maps are considered equal.
+func (in SLISpec) Equal(other SLISpec) bool {
+	if in.Description != other.Description {
+		return false
+	}
+	if (in.ThresholdMetric == nil) != (other.ThresholdMetric == nil) {
+		return false
+	}
+	if in.ThresholdMetric != nil {
+		if !(*in.ThresholdMetric).Equal(*other.ThresholdMetric) {
+			return false
+		}
+	}
+	if (in.RatioMetric == nil) != (other.RatioMetric == nil) {
+		return false
+	}
+	if in.RatioMetric != nil {
+		if !(*in.RatioMetric).Equal(*other.RatioMetric) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLO.
+func (in SLO) DeepCopy() SLO {
+	out := in
+	out.Metadata = in.Metadata.DeepCopy()
+	out.Spec = in.Spec.DeepCopy()
+	return out
+}
+
+// Equal reports whether the SLO is deeply equal to the other SLO.
+// Nil and empty slices and maps are considered equal.
+func (in SLO) Equal(other SLO) bool {
+	if in.APIVersion != other.APIVersion {
+		return false
+	}
+	if in.Kind != other.Kind {
+		return false
+	}
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOAlertPolicy.
+func (in SLOAlertPolicy) DeepCopy() SLOAlertPolicy {
+	out := in
+	if in.SLOAlertPolicyInline != nil {
+		ptr1 := (*in.SLOAlertPolicyInline).DeepCopy()
+		out.SLOAlertPolicyInline = &ptr1
+	}
+	if in.SLOAlertPolicyRef != nil {
+		ptr1 := (*in.SLOAlertPolicyRef).DeepCopy()
+		out.SLOAlertPolicyRef = &ptr1
+	}
+	return out
+}
+
+// Equal reports whether the SLOAlertPolicy is deeply equal to the other SLOAlertPolicy.
+// Nil and empty slices and maps are considered equal.
+func (in SLOAlertPolicy) Equal(other SLOAlertPolicy) bool {
+	if (in.SLOAlertPolicyInline == nil) != (other.SLOAlertPolicyInline == nil) {
+		return false
+	}
+	if in.SLOAlertPolicyInline != nil {
+		if !(*in.SLOAlertPolicyInline).Equal(*other.SLOAlertPolicyInline) {
+			return false
+		}
+	}
+	if (in.SLOAlertPolicyRef == nil) != (other.SLOAlertPolicyRef == nil) {
+		return false
+	}
+	if in.SLOAlertPolicyRef != nil {
+		if !(*in.SLOAlertPolicyRef).Equal(*other.SLOAlertPolicyRef) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOAlertPolicyInline.
+func (in SLOAlertPolicyInline) DeepCopy() SLOAlertPolicyInline {
+	out := in
+	out.Metadata = in.Metadata.DeepCopy()
+	out.Spec = in.Spec.DeepCopy()
+	return out
+}
+
+// Equal reports whether the SLOAlertPolicyInline is deeply equal to the other SLOAlertPolicyInline.
+// Nil and empty slices and maps are considered equal.
+func (in SLOAlertPolicyInline) Equal(other SLOAlertPolicyInline) bool {
+	if in.Kind != other.Kind {
+		return false
+	}
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOAlertPolicyRef.
+func (in SLOAlertPolicyRef) DeepCopy() SLOAlertPolicyRef {
+	return in
+}
+
+// Equal reports whether the SLOAlertPolicyRef is deeply equal to the other SLOAlertPolicyRef.
+// Nil and empty slices and maps are considered equal.
+func (in SLOAlertPolicyRef) Equal(other SLOAlertPolicyRef) bool {
+	if in.AlertPolicyRef != other.AlertPolicyRef {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOCalendar.
+func (in SLOCalendar) DeepCopy() SLOCalendar {
+	return in
+}
+
+// Equal reports whether the SLOCalendar is deeply equal to the other SLOCalendar.
+// Nil and empty slices and maps are considered equal.
+func (in SLOCalendar) Equal(other SLOCalendar) bool {
+	if in.StartTime != other.StartTime {
+		return false
+	}
+	if in.TimeZone != other.TimeZone {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOObjective.
+func (in SLOObjective) DeepCopy() SLOObjective {
+	out := in
+	if in.Value != nil {
+		ptr1 := *in.Value
+		out.Value = &ptr1
+	}
+	if in.Target != nil {
+		ptr1 := *in.Target
+		out.Target = &ptr1
+	}
+	if in.TargetPercent != nil {
+		ptr1 := *in.TargetPercent
+		out.TargetPercent = &ptr1
+	}
+	if in.TimeSliceTarget != nil {
+		ptr1 := *in.TimeSliceTarget
+		out.TimeSliceTarget = &ptr1
+	}
+	if in.TimeSliceWindow != nil {
+		ptr1 := (*in.TimeSliceWindow).DeepCopy()
+		out.TimeSliceWindow = &ptr1
+	}
+	if in.SLI != nil {
+		ptr1 := (*in.SLI).DeepCopy()
+		out.SLI = &ptr1
+	}
+	if in.SLIRef != nil {
+		ptr1 := *in.SLIRef
+		out.SLIRef = &ptr1
+	}
+	if in.CompositeWeight != nil {
+		ptr1 := *in.CompositeWeight
+		out.CompositeWeight = &ptr1
+	}
+	return out
+}
+
+// Equal reports whether the SLOObjective is deeply equal to the other SLOObjective.
+// Nil and empty slices and maps are considered equal.
+func (in SLOObjective) Equal(other SLOObjective) bool {
+	if in.DisplayName != other.DisplayName {
+		return false
+	}
+	if in.Operator != other.Operator {
+		return false
+	}
+	if (in.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if in.Value != nil {
+		if *in.Value != *other.Value {
+			return false
+		}
+	}
+	if (in.Target == nil) != (other.Target == nil) {
+		return false
+	}
+	if in.Target != nil {
+		if *in.Target != *other.Target {
+			return false
+		}
+	}
+	if (in.TargetPercent == nil) != (other.TargetPercent == nil) {
+		return false
+	}
+	if in.TargetPercent != nil {
+		if *in.TargetPercent != *other.TargetPercent {
+			return false
+		}
+	}
+	if (in.TimeSliceTarget == nil) != (other.TimeSliceTarget == nil) {
+		return false
+	}
+	if in.TimeSliceTarget != nil {
+		if *in.TimeSliceTarget != *other.TimeSliceTarget {
+			return false
+		}
+	}
+	if (in.TimeSliceWindow == nil) != (other.TimeSliceWindow == nil) {
+		return false
+	}
+	if in.TimeSliceWindow != nil {
+		if !(*in.TimeSliceWindow).Equal(*other.TimeSliceWindow) {
+			return false
+		}
+	}
+	if (in.SLI == nil) != (other.SLI == nil) {
+		return false
+	}
+	if in.SLI != nil {
+		if !(*in.SLI).Equal(*other.SLI) {
+			return false
+		}
+	}
+	if (in.SLIRef == nil) != (other.SLIRef == nil) {
+		return false
+	}
+	if in.SLIRef != nil {
+		if *in.SLIRef != *other.SLIRef {
+			return false
+		}
+	}
+	if (in.CompositeWeight == nil) != (other.CompositeWeight == nil) {
+		return false
+	}
+	if in.CompositeWeight != nil {
+		if *in.CompositeWeight != *other.CompositeWeight {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOSLIInline.
+func (in SLOSLIInline) DeepCopy() SLOSLIInline {
+	out := in
+	out.Metadata = in.Metadata.DeepCopy()
+	out.Spec = in.Spec.DeepCopy()
+	return out
+}
+
+// Equal reports whether the SLOSLIInline is deeply equal to the other SLOSLIInline.
+// Nil and empty slices and maps are considered equal.
+func (in SLOSLIInline) Equal(other SLOSLIInline) bool {
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOSpec.
+func (in SLOSpec) DeepCopy() SLOSpec {
+	out := in
+	if in.SLI != nil {
+		ptr1 := (*in.SLI).DeepCopy()
+		out.SLI = &ptr1
+	}
+	if in.SLIRef != nil {
+		ptr1 := *in.SLIRef
+		out.SLIRef = &ptr1
+	}
+	if in.TimeWindow != nil {
+		out.TimeWindow = make([]SLOTimeWindow, len(in.TimeWindow))
+		copy(out.TimeWindow, in.TimeWindow)
+		for i1 := range in.TimeWindow {
+			out.TimeWindow[i1] = in.TimeWindow[i1].DeepCopy()
+		}
+	}
+	if in.Objectives != nil {
+		out.Objectives = make([]SLOObjective, len(in.Objectives))
+		copy(out.Objectives, in.Objectives)
+		for i1 := range in.Objectives {
+			out.Objectives[i1] = in.Objectives[i1].DeepCopy()
+		}
+	}
+	if in.AlertPolicies != nil {
+		out.AlertPolicies = make([]SLOAlertPolicy, len(in.AlertPolicies))
+		copy(out.AlertPolicies, in.AlertPolicies)
+		for i1 := range in.AlertPolicies {
+			out.AlertPolicies[i1] = in.AlertPolicies[i1].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether the SLOSpec is deeply equal to the other SLOSpec.
+// Nil and empty slices and maps are considered equal.
+func (in SLOSpec) Equal(other SLOSpec) bool {
+	if in.Description != other.Description {
+		return false
+	}
+	if in.ServiceRef != other.ServiceRef {
+		return false
+	}
+	if (in.SLI == nil) != (other.SLI == nil) {
+		return false
+	}
+	if in.SLI != nil {
+		if !(*in.SLI).Equal(*other.SLI) {
+			return false
+		}
+	}
+	if (in.SLIRef == nil) != (other.SLIRef == nil) {
+		return false
+	}
+	if in.SLIRef != nil {
+		if *in.SLIRef != *other.SLIRef {
+			return false
+		}
+	}
+	if in.BudgetingMethod != other.BudgetingMethod {
+		return false
+	}
+	if len(in.TimeWindow) != len(other.TimeWindow) {
+		return false
+	}
+	for i1 := range in.TimeWindow {
+		if !in.TimeWindow[i1].Equal(other.TimeWindow[i1]) {
+			return false
+		}
+	}
+	if len(in.Objectives) != len(other.Objectives) {
+		return false
+	}
+	for i1 := range in.Objectives {
+		if !in.Objectives[i1].Equal(other.Objectives[i1]) {
+			return false
+		}
+	}
+	if len(in.AlertPolicies) != len(other.AlertPolicies) {
+		return false
+	}
+	for i1 := range in.AlertPolicies {
+		if !in.AlertPolicies[i1].Equal(other.AlertPolicies[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the SLOTimeWindow.
+func (in SLOTimeWindow) DeepCopy() SLOTimeWindow {
+	out := in
+	if in.Calendar != nil {
+		ptr1 := (*in.Calendar).DeepCopy()
+		out.Calendar = &ptr1
+	}
+	return out
+}
+
+// Equal reports whether the SLOTimeWindow is deeply equal to the other SLOTimeWindow.
+// Nil and empty slices and maps are considered equal.
+func (in SLOTimeWindow) Equal(other SLOTimeWindow) bool {
+	if !in.Duration.Equal(other.Duration) {
+		return false
+	}
+	if in.IsRolling != other.IsRolling {
+		return false
+	}
+	if (in.Calendar == nil) != (other.Calendar == nil) {
+		return false
+	}
+	if in.Calendar != nil {
+		if !(*in.Calendar).Equal(*other.Calendar) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Service.
+func (in Service) DeepCopy() Service {
+	out := in
+	out.Metadata = in.Metadata.DeepCopy()
+	return out
+}
+
+// Equal reports whether the Service is deeply equal to the other Service.
+// Nil and empty slices and maps are considered equal.
+func (in Service) Equal(other Service) bool {
+	if in.APIVersion != other.APIVersion {
+		return false
+	}
+	if in.Kind != other.Kind {
+		return false
+	}
+	if !in.Metadata.Equal(other.Metadata) {
+		return false
+	}
+	if !in.Spec.Equal(other.Spec) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the ServiceSpec.
+func (in ServiceSpec) DeepCopy() ServiceSpec {
+	return in
+}
+
+// Equal reports whether the ServiceSpec is deeply equal to the other ServiceSpec.
+// Nil and empty slices and maps are considered equal.
+func (in ServiceSpec) Equal(other ServiceSpec) bool {
+	if in.Description != other.Description {
+		return false
+	}
+	return true
+}
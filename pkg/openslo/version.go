@@ -3,6 +3,7 @@ package openslo
 import "fmt"
 
 // Version represents a version of the OpenSLO specification.
+// Custom versions can be added with [RegisterObject].
 type Version string
 
 const (
@@ -30,6 +31,9 @@ func (v Version) Validate() error {
 		VersionV2alpha:
 		return nil
 	default:
+		if isVersionRegistered(v) {
+			return nil
+		}
 		return fmt.Errorf("unsupported %[1]T: %[1]s", v)
 	}
 }
@@ -0,0 +1,182 @@
+package openslosdk
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/budget"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+)
+
+// ShortWindowAnnotation is the [v1.AlertCondition] annotation key which holds the short window
+// of a multi-window burn rate alert, see [NewMultiBurnRateAlerts].
+// Since [v1.AlertConditionType] supports only a single lookback window, which is used as the long window,
+// the short window is stored in an annotation.
+// The alert should only fire if the burn rate threshold is exceeded in both windows.
+const ShortWindowAnnotation = "openslo.com/short-window"
+
+// BurnRateAlertWindow defines a single alert of the multi-window multi-burn-rate alerting strategy.
+type BurnRateAlertWindow struct {
+	// Severity of the generated [v1.AlertCondition], e.g. 'page' or 'ticket'.
+	Severity string
+	// LongWindow is the lookback window over which the budget consumption is measured.
+	LongWindow v1.DurationShorthand
+	// ShortWindow is used to check if the budget is still being consumed,
+	// which shortens the alert reset time.
+	ShortWindow v1.DurationShorthand
+	// BudgetConsumed is the ratio of the error budget which has to be consumed within the long window
+	// for the alert to fire, e.g. 0.02 for 2%.
+	// It must be greater than 0 and less than or equal to 1.
+	BudgetConsumed float64
+}
+
+// DefaultBurnRateAlertWindows returns the alert windows recommended by the Google SRE workbook:
+//   - page when 2% of the error budget is consumed within 1 hour (short window of 5 minutes)
+//   - page when 5% of the error budget is consumed within 6 hours (short window of 30 minutes)
+//   - ticket when 10% of the error budget is consumed within 3 days (short window of 6 hours)
+func DefaultBurnRateAlertWindows() []BurnRateAlertWindow {
+	return []BurnRateAlertWindow{
+		{
+			Severity:       "page",
+			LongWindow:     v1.NewDurationShorthand(1, v1.DurationShorthandUnitHour),
+			ShortWindow:    v1.NewDurationShorthand(5, v1.DurationShorthandUnitMinute),
+			BudgetConsumed: 0.02,
+		},
+		{
+			Severity:       "page",
+			LongWindow:     v1.NewDurationShorthand(6, v1.DurationShorthandUnitHour),
+			ShortWindow:    v1.NewDurationShorthand(30, v1.DurationShorthandUnitMinute),
+			BudgetConsumed: 0.05,
+		},
+		{
+			Severity:       "ticket",
+			LongWindow:     v1.NewDurationShorthand(3, v1.DurationShorthandUnitDay),
+			ShortWindow:    v1.NewDurationShorthand(6, v1.DurationShorthandUnitHour),
+			BudgetConsumed: 0.1,
+		},
+	}
+}
+
+// NewMultiBurnRateAlerts synthesizes multi-window multi-burn-rate alerts for the [v1.SLO] objective
+// at the provided index.
+// If no windows are provided, [DefaultBurnRateAlertWindows] are used.
+//
+// For each window, a [v1.AlertCondition] and a [v1.AlertPolicy] referencing it are returned.
+// The burn rate threshold is computed from the SLO time window and the window's budget consumption,
+// e.g. consuming 2% of a 30 day error budget within 1 hour equals a burn rate of 14.4.
+// Months, quarters and years are approximated the same way as [v1.DurationShorthand.Duration] does.
+// The short window is stored in the [ShortWindowAnnotation].
+//
+// The notification targets map severities to the names of [v1.AlertNotificationTarget],
+// which are referenced by the generated [v1.AlertPolicy] objects.
+// Every severity used by the windows must have at least one notification target.
+// The names of the generated objects are derived from the window's severity and long window,
+// hence no two windows can share both of them.
+//
+// The generated [v1.AlertPolicy] objects are not added to the [v1.SLO],
+// it is up to the caller to reference them in [v1.SLOSpec.AlertPolicies].
+func NewMultiBurnRateAlerts(
+	slo v1.SLO,
+	objectiveIndex int,
+	notificationTargets map[string][]string,
+	windows ...BurnRateAlertWindow,
+) ([]openslo.Object, error) {
+	objects, err := newMultiBurnRateAlerts(slo, objectiveIndex, notificationTargets, windows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create burn rate alerts for %s: %w", slo, err)
+	}
+	return objects, nil
+}
+
+func newMultiBurnRateAlerts(
+	slo v1.SLO,
+	objectiveIndex int,
+	notificationTargets map[string][]string,
+	windows []BurnRateAlertWindow,
+) ([]openslo.Object, error) {
+	if len(windows) == 0 {
+		windows = DefaultBurnRateAlertWindows()
+	}
+	if len(slo.Spec.TimeWindow) == 0 {
+		return nil, errors.New("'spec.timeWindow' is required")
+	}
+	if objectiveIndex < 0 || objectiveIndex >= len(slo.Spec.Objectives) {
+		return nil, fmt.Errorf("objective at index %d does not exist", objectiveIndex)
+	}
+	objective, err := budget.NewV1Objective(slo, objectiveIndex)
+	if err != nil {
+		return nil, err
+	}
+	target := objective.Target
+	timeWindow := slo.Spec.TimeWindow[0].Duration
+	objects := make([]openslo.Object, 0, len(windows)*2)
+	names := make(map[string]int, len(windows))
+	for i, window := range windows {
+		if len(notificationTargets[window.Severity]) == 0 {
+			return nil, fmt.Errorf("no notification targets defined for '%s' severity", window.Severity)
+		}
+		if window.LongWindow.GetValue() == 0 || window.ShortWindow.GetValue() == 0 {
+			return nil, fmt.Errorf("long and short windows must be set for '%s' severity", window.Severity)
+		}
+		if !(window.BudgetConsumed > 0 && window.BudgetConsumed <= 1) {
+			return nil, fmt.Errorf("budget consumed must be greater than 0 and less than or equal to 1"+
+				" for '%s' severity, got: %v", window.Severity, window.BudgetConsumed)
+		}
+		burnRate := round(window.BudgetConsumed*float64(timeWindow.Duration())/
+			float64(window.LongWindow.Duration()), 3)
+		name := fmt.Sprintf("%s-%s-%s", slo.Metadata.Name, window.Severity, window.LongWindow)
+		if objectiveIndex > 0 {
+			name = fmt.Sprintf("%s-%s-%d-%s", slo.Metadata.Name, window.Severity, objectiveIndex, window.LongWindow)
+		}
+		if j, ok := names[name]; ok {
+			return nil, fmt.Errorf("windows at indexes %d and %d have the same '%s' severity and %s long window",
+				j, i, window.Severity, window.LongWindow)
+		}
+		names[name] = i
+		condition := v1.NewAlertCondition(
+			v1.Metadata{
+				Name:        name,
+				Annotations: v1.Annotations{ShortWindowAnnotation: window.ShortWindow.String()},
+			},
+			v1.AlertConditionSpec{
+				Description: fmt.Sprintf("Fires when %g%% of the %s error budget is consumed within %s,"+
+					" which for %g target means the error rate exceeds %g in both %s and %s windows",
+					round(window.BudgetConsumed*100, 3), timeWindow, window.LongWindow,
+					target, round(burnRate*(1-target), 6), window.LongWindow, window.ShortWindow),
+				Severity: window.Severity,
+				Condition: v1.AlertConditionType{
+					Kind:           v1.AlertConditionKindBurnRate,
+					Operator:       v1.OperatorGTE,
+					Threshold:      ptr(burnRate),
+					LookbackWindow: window.LongWindow,
+				},
+			},
+		)
+		policy := v1.NewAlertPolicy(
+			v1.Metadata{Name: name},
+			v1.AlertPolicySpec{
+				AlertWhenBreaching: true,
+				Conditions: []v1.AlertPolicyCondition{{
+					AlertPolicyConditionRef: &v1.AlertPolicyConditionRef{ConditionRef: name},
+				}},
+			},
+		)
+		for _, targetRef := range notificationTargets[window.Severity] {
+			policy.Spec.NotificationTargets = append(policy.Spec.NotificationTargets, v1.AlertPolicyNotificationTarget{
+				AlertPolicyNotificationTargetRef: &v1.AlertPolicyNotificationTargetRef{TargetRef: targetRef},
+			})
+		}
+		objects = append(objects, condition, policy)
+	}
+	if err = Validate(objects...); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+func round(v float64, precision int) float64 {
+	p := math.Pow10(precision)
+	return math.Round(v*p) / p
+}
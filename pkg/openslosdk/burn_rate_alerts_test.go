@@ -0,0 +1,150 @@
+package openslosdk
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+)
+
+func TestNewMultiBurnRateAlerts(t *testing.T) {
+	slo := newBurnRateAlertsTestSLO()
+	notificationTargets := map[string][]string{
+		"page":   {"on-call"},
+		"ticket": {"team-email", "team-slack"},
+	}
+
+	objects, err := NewMultiBurnRateAlerts(slo, 0, notificationTargets)
+
+	assert.Require(t, assert.NoError(t, err))
+	assert.Require(t, assert.Len(t, objects, 6))
+	var buf bytes.Buffer
+	err = Encode(&buf, FormatYAML, objects...)
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, string(readTestData(t, testData, "burn_rate_alerts/default.yaml")), buf.String())
+}
+
+func TestNewMultiBurnRateAlerts_CustomWindows(t *testing.T) {
+	slo := newBurnRateAlertsTestSLO()
+	slo.Spec.TimeWindow[0].Duration = v1.NewDurationShorthand(1, v1.DurationShorthandUnitWeek)
+	slo.Spec.Objectives = append(slo.Spec.Objectives, v1.SLOObjective{TargetPercent: ptr(99.0)})
+
+	objects, err := NewMultiBurnRateAlerts(slo, 1, map[string][]string{"critical": {"on-call"}},
+		BurnRateAlertWindow{
+			Severity:       "critical",
+			LongWindow:     v1.NewDurationShorthand(2, v1.DurationShorthandUnitHour),
+			ShortWindow:    v1.NewDurationShorthand(10, v1.DurationShorthandUnitMinute),
+			BudgetConsumed: 0.05,
+		},
+	)
+
+	assert.Require(t, assert.NoError(t, err))
+	conditions := FilterByType[v1.AlertCondition](objects)
+	assert.Require(t, assert.Len(t, conditions, 1))
+	condition := conditions[0]
+	assert.Equal(t, "my-slo-critical-1-2h", condition.Metadata.Name)
+	assert.Equal(t, "10m", condition.Metadata.Annotations[ShortWindowAnnotation])
+	assert.Equal(t, 4.2, *condition.Spec.Condition.Threshold)
+	policies := FilterByType[v1.AlertPolicy](objects)
+	assert.Require(t, assert.Len(t, policies, 1))
+	assert.Equal(t, "my-slo-critical-1-2h", policies[0].Spec.Conditions[0].ConditionRef)
+}
+
+func TestNewMultiBurnRateAlerts_Errors(t *testing.T) {
+	tests := map[string]struct {
+		objectiveIndex      int
+		notificationTargets map[string][]string
+		windows             []BurnRateAlertWindow
+		err                 string
+	}{
+		"missing objective": {
+			objectiveIndex:      1,
+			notificationTargets: map[string][]string{"page": {"on-call"}, "ticket": {"on-call"}},
+			err: "failed to create burn rate alerts for v1.SLO 'my-slo':" +
+				" objective at index 1 does not exist",
+		},
+		"missing notification targets": {
+			notificationTargets: map[string][]string{"page": {"on-call"}},
+			err: "failed to create burn rate alerts for v1.SLO 'my-slo':" +
+				" no notification targets defined for 'ticket' severity",
+		},
+		"duplicated window names": {
+			notificationTargets: map[string][]string{"page": {"on-call"}},
+			windows: []BurnRateAlertWindow{
+				{
+					Severity:       "page",
+					LongWindow:     v1.NewDurationShorthand(1, v1.DurationShorthandUnitHour),
+					ShortWindow:    v1.NewDurationShorthand(5, v1.DurationShorthandUnitMinute),
+					BudgetConsumed: 0.02,
+				},
+				{
+					Severity:       "page",
+					LongWindow:     v1.NewDurationShorthand(1, v1.DurationShorthandUnitHour),
+					ShortWindow:    v1.NewDurationShorthand(10, v1.DurationShorthandUnitMinute),
+					BudgetConsumed: 0.05,
+				},
+			},
+			err: "failed to create burn rate alerts for v1.SLO 'my-slo':" +
+				" windows at indexes 0 and 1 have the same 'page' severity and 1h long window",
+		},
+		"zero budget consumed": {
+			notificationTargets: map[string][]string{"page": {"on-call"}},
+			windows: []BurnRateAlertWindow{{
+				Severity:       "page",
+				LongWindow:     v1.NewDurationShorthand(1, v1.DurationShorthandUnitHour),
+				ShortWindow:    v1.NewDurationShorthand(5, v1.DurationShorthandUnitMinute),
+				BudgetConsumed: 0,
+			}},
+			err: "failed to create burn rate alerts for v1.SLO 'my-slo':" +
+				" budget consumed must be greater than 0 and less than or equal to 1 for 'page' severity, got: 0",
+		},
+		"negative budget consumed": {
+			notificationTargets: map[string][]string{"page": {"on-call"}},
+			windows: []BurnRateAlertWindow{{
+				Severity:       "page",
+				LongWindow:     v1.NewDurationShorthand(1, v1.DurationShorthandUnitHour),
+				ShortWindow:    v1.NewDurationShorthand(5, v1.DurationShorthandUnitMinute),
+				BudgetConsumed: -0.02,
+			}},
+			err: "failed to create burn rate alerts for v1.SLO 'my-slo':" +
+				" budget consumed must be greater than 0 and less than or equal to 1 for 'page' severity, got: -0.02",
+		},
+		"budget consumed above 1": {
+			notificationTargets: map[string][]string{"page": {"on-call"}},
+			windows: []BurnRateAlertWindow{{
+				Severity:       "page",
+				LongWindow:     v1.NewDurationShorthand(1, v1.DurationShorthandUnitHour),
+				ShortWindow:    v1.NewDurationShorthand(5, v1.DurationShorthandUnitMinute),
+				BudgetConsumed: 1.5,
+			}},
+			err: "failed to create burn rate alerts for v1.SLO 'my-slo':" +
+				" budget consumed must be greater than 0 and less than or equal to 1 for 'page' severity, got: 1.5",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewMultiBurnRateAlerts(
+				newBurnRateAlertsTestSLO(),
+				test.objectiveIndex,
+				test.notificationTargets,
+				test.windows...,
+			)
+			assert.Require(t, assert.Error(t, err))
+			assert.Equal(t, test.err, err.Error())
+		})
+	}
+}
+
+func newBurnRateAlertsTestSLO() v1.SLO {
+	return v1.NewSLO(v1.Metadata{Name: "my-slo"}, v1.SLOSpec{
+		Service:         "web",
+		IndicatorRef:    ptr("my-sli"),
+		BudgetingMethod: v1.SLOBudgetingMethodOccurrences,
+		TimeWindow: []v1.SLOTimeWindow{{
+			Duration:  v1.NewDurationShorthand(30, v1.DurationShorthandUnitDay),
+			IsRolling: true,
+		}},
+		Objectives: []v1.SLOObjective{{Target: ptr(0.999)}},
+	})
+}
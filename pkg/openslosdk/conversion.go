@@ -0,0 +1,104 @@
+package openslosdk
+
+import (
+	"fmt"
+
+	"github.com/OpenSLO/go-sdk/internal"
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+)
+
+// Convert converts the provided [openslo.Object] slice to the target [openslo.Version].
+// Objects which are already in the target version are returned as is.
+// A single object may be converted into multiple objects,
+// for instance [v1alpha.SLO] metrics are extracted into separate [v1.SLI] objects.
+//
+// Along with the converted objects, a list of [ConversionIssue] is returned.
+// It describes every property which could not be mapped to the target version
+// or was mapped with a loss of information.
+// Review these before relying on the converted objects.
+//
+// Supported conversions:
+//   - [openslo.VersionV1alpha] to [openslo.VersionV1]
+//   - [openslo.VersionV1] to [openslo.VersionV2alpha]
+//   - [openslo.VersionV2alpha] to [openslo.VersionV1]
+func Convert(objects []openslo.Object, targetVersion openslo.Version) ([]openslo.Object, []ConversionIssue, error) {
+	if err := targetVersion.Validate(); err != nil {
+		return nil, nil, err
+	}
+	c := converter{}
+	converted := make([]openslo.Object, 0, len(objects))
+	for i, object := range objects {
+		c.objectIndex = i
+		c.objectName = internal.GetObjectName(object)
+		result, err := c.convertObject(object, targetVersion)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to convert %s to %s: %w", object, targetVersion, err)
+		}
+		converted = append(converted, result...)
+	}
+	return converted, c.issues, nil
+}
+
+// DisplayNameAnnotation is the annotation key used by [Convert] to preserve [v1.Metadata] display name
+// when converting objects to [openslo.VersionV2alpha], which does not support display names.
+// When converting back to [openslo.VersionV1], the annotation is moved back to the display name.
+const DisplayNameAnnotation = "openslo.com/display-name"
+
+// ConversionIssue describes a property of the converted [openslo.Object]
+// which could not be mapped to the target version or was mapped with a loss of information.
+type ConversionIssue struct {
+	// ObjectIndex is the index of the converted object in the slice passed to [Convert].
+	ObjectIndex int `json:"objectIndex"`
+	// ObjectName is the name of the converted object, e.g. "v1alpha.SLO 'my-slo'".
+	ObjectName string `json:"objectName"`
+	// PropertyPath is the path of the affected property, relative to the root of the converted object.
+	PropertyPath string `json:"propertyPath"`
+	// Message describes the issue.
+	Message string `json:"message"`
+}
+
+func (c ConversionIssue) String() string {
+	return fmt.Sprintf("%s at index %d: '%s': %s", c.ObjectName, c.ObjectIndex, c.PropertyPath, c.Message)
+}
+
+type converter struct {
+	objectIndex int
+	objectName  string
+	issues      []ConversionIssue
+}
+
+func (c *converter) convertObject(object openslo.Object, targetVersion openslo.Version) ([]openslo.Object, error) {
+	sourceVersion := object.GetVersion()
+	switch {
+	case sourceVersion == targetVersion:
+		return []openslo.Object{object}, nil
+	case sourceVersion == openslo.VersionV1alpha && targetVersion == openslo.VersionV1:
+		return c.convertV1alphaToV1(object)
+	case sourceVersion == openslo.VersionV1 && targetVersion == openslo.VersionV2alpha:
+		return c.convertV1ToV2alpha(object)
+	case sourceVersion == openslo.VersionV2alpha && targetVersion == openslo.VersionV1:
+		return c.convertV2alphaToV1(object)
+	default:
+		return nil, fmt.Errorf("conversion from %s to %s is not supported", sourceVersion, targetVersion)
+	}
+}
+
+// report records a [ConversionIssue] for the currently converted object.
+func (c *converter) report(path, format string, a ...any) {
+	c.issues = append(c.issues, ConversionIssue{
+		ObjectIndex:  c.objectIndex,
+		ObjectName:   c.objectName,
+		PropertyPath: path,
+		Message:      fmt.Sprintf(format, a...),
+	})
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func clonePtr[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
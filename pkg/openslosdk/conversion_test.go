@@ -0,0 +1,268 @@
+package openslosdk
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal"
+	"github.com/OpenSLO/go-sdk/internal/assert"
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+func TestConvert(t *testing.T) {
+	root := internal.FindModuleRoot()
+	testDataPath := filepath.Join(root, "pkg", "openslosdk", "test_data", "convert")
+
+	tests := map[string]struct {
+		filename string
+		version  openslo.Version
+		issues   []ConversionIssue
+		// incomplete is set when the converted objects are not valid until the issues are addressed.
+		incomplete bool
+	}{
+		"v1alpha to v1": {
+			filename: "v1alpha_to_v1.yaml",
+			version:  openslo.VersionV1,
+			issues: []ConversionIssue{
+				{
+					ObjectIndex:  2,
+					ObjectName:   "v1alpha.SLO 'web-availability'",
+					PropertyPath: "spec.objectives[0].value",
+					Message:      "value is not supported by v1 for ratio metrics and was removed",
+				},
+				{
+					ObjectIndex:  2,
+					ObjectName:   "v1alpha.SLO 'web-availability'",
+					PropertyPath: "spec.objectives[1].value",
+					Message:      "value is not supported by v1 for ratio metrics and was removed",
+				},
+			},
+		},
+		"v1alpha to v1 with different ratio metrics and timeslices": {
+			filename: "v1alpha_to_v1_lossy.yaml",
+			version:  openslo.VersionV1,
+			issues: []ConversionIssue{
+				{
+					ObjectName:   "v1alpha.SLO 'web-availability'",
+					PropertyPath: "spec.timeWindows[0].count",
+					Message:      "v1 does not support second precision, 90 seconds were rounded up to 2 minutes",
+				},
+				{
+					ObjectName:   "v1alpha.SLO 'web-availability'",
+					PropertyPath: "spec.objectives[0].value",
+					Message:      "value is not supported by v1 for ratio metrics and was removed",
+				},
+				{
+					ObjectName:   "v1alpha.SLO 'web-availability'",
+					PropertyPath: "spec.objectives[0].timeSliceWindow",
+					Message: "timeSliceWindow is required by v1 for Timeslices budgeting method" +
+						" and has no v1alpha equivalent, it must be set manually",
+				},
+				{
+					ObjectName:   "v1alpha.SLO 'web-availability'",
+					PropertyPath: "spec.objectives[1].value",
+					Message:      "value is not supported by v1 for ratio metrics and was removed",
+				},
+				{
+					ObjectName:   "v1alpha.SLO 'web-availability'",
+					PropertyPath: "spec.objectives[1].timeSliceWindow",
+					Message: "timeSliceWindow is required by v1 for Timeslices budgeting method" +
+						" and has no v1alpha equivalent, it must be set manually",
+				},
+				{
+					ObjectName:   "v1alpha.SLO 'web-availability'",
+					PropertyPath: "spec.objectives",
+					Message: "objectives define different ratio metrics," +
+						" each of them was converted into a separate v1.SLI which results in a composite v1.SLO",
+				},
+			},
+			incomplete: true,
+		},
+		"v1 to v2alpha": {
+			filename: "v1_to_v2alpha.yaml",
+			version:  openslo.VersionV2alpha,
+			issues: []ConversionIssue{
+				{
+					ObjectName:   "v1.Service 'web'",
+					PropertyPath: "metadata.displayName",
+					Message: "v2alpha does not support display names," +
+						" it was moved to 'openslo.com/display-name' annotation",
+				},
+				{
+					ObjectName:   "v1.Service 'web'",
+					PropertyPath: "metadata.labels.team",
+					Message: "v2alpha does not support multi-value labels," +
+						" only the first value 'green' was kept out of: green, blue",
+				},
+				{
+					ObjectIndex:  2,
+					ObjectName:   "v1.SLI 'web-availability'",
+					PropertyPath: "spec.ratioMetric.good.metricSource.type",
+					Message: "v2alpha infers the metric source type from the referenced DataSource," +
+						" type 'Prometheus' was removed",
+				},
+				{
+					ObjectIndex:  3,
+					ObjectName:   "v1.AlertCondition 'cpu-usage-breach'",
+					PropertyPath: "spec.condition.alertAfter",
+					Message:      "alertAfter is required by v2alpha and has no default, it must be set manually",
+				},
+				{
+					ObjectIndex:  6,
+					ObjectName:   "v1.SLO 'web-availability'",
+					PropertyPath: "metadata.displayName",
+					Message: "v2alpha does not support display names," +
+						" it was moved to 'openslo.com/display-name' annotation",
+				},
+				{
+					ObjectIndex:  6,
+					ObjectName:   "v1.SLO 'web-availability'",
+					PropertyPath: "spec.timeWindow[0].duration",
+					Message:      "v2alpha does not support 'M' unit, '1M' was approximated to '30d'",
+				},
+				{
+					ObjectIndex:  7,
+					ObjectName:   "v1.SLO 'web-latency'",
+					PropertyPath: "spec.indicator.spec.thresholdMetric.metricSource.spec",
+					Message: "v2alpha requires connection details of inline data sources," +
+						" they must be moved from the metric source spec manually",
+				},
+			},
+			incomplete: true,
+		},
+		"v2alpha to v1": {
+			filename: "v2alpha_to_v1.yaml",
+			version:  openslo.VersionV1,
+			issues: []ConversionIssue{
+				{
+					ObjectIndex:  7,
+					ObjectName:   "v2alpha.SLO 'web-latency'",
+					PropertyPath: "spec.sli.spec.thresholdMetric.dataSourceSpec.connectionDetails",
+					Message: "v1 does not support inline data sources," +
+						" connection details were merged into the metric source spec",
+				},
+				{
+					ObjectIndex:  7,
+					ObjectName:   "v2alpha.SLO 'web-latency'",
+					PropertyPath: "spec.sli.spec.thresholdMetric.dataSourceSpec.description",
+					Message:      "v1 does not support inline data sources, description was removed",
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Read input.
+			inputPath := filepath.Join(testDataPath, "inputs", test.filename)
+			inputFileData, err := os.ReadFile(inputPath)
+			assert.Require(t, assert.NoError(t, err))
+			inputObjects, err := Decode(bytes.NewReader(inputFileData), FormatYAML)
+			assert.Require(t, assert.NoError(t, err))
+			err = Validate(inputObjects...)
+			assert.Require(t, assert.NoError(t, err))
+
+			// Convert objects.
+			convertedObjects, issues, err := Convert(inputObjects, test.version)
+			assert.Require(t, assert.NoError(t, err))
+			assert.Equal(t, test.issues, issues)
+
+			// Read output.
+			outputPath := filepath.Join(testDataPath, "outputs", test.filename)
+			outputsFileData, err := os.ReadFile(outputPath)
+			assert.Require(t, assert.NoError(t, err))
+
+			// Check.
+			if !test.incomplete {
+				err = Validate(convertedObjects...)
+				assert.Require(t, assert.NoError(t, err))
+			}
+			var buf bytes.Buffer
+			err = Encode(&buf, FormatYAML, convertedObjects...)
+			assert.Require(t, assert.NoError(t, err))
+			assert.Equal(t, string(outputsFileData), buf.String())
+		})
+	}
+}
+
+func TestConvert_Errors(t *testing.T) {
+	service := v1.NewService(v1.Metadata{Name: "web"}, v1.ServiceSpec{})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, _, err := Convert([]openslo.Object{service}, "openslo/v3")
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "unsupported openslo.Version: openslo/v3", err.Error())
+	})
+	t.Run("unsupported conversion", func(t *testing.T) {
+		_, _, err := Convert([]openslo.Object{service}, openslo.VersionV1alpha)
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "failed to convert v1.Service 'web' to openslo/v1alpha:"+
+			" conversion from openslo/v1 to openslo/v1alpha is not supported", err.Error())
+	})
+	t.Run("same version", func(t *testing.T) {
+		objects, issues, err := Convert([]openslo.Object{service}, openslo.VersionV1)
+		assert.Require(t, assert.NoError(t, err))
+		assert.Len(t, issues, 0)
+		assert.Equal(t, []openslo.Object{service}, objects)
+	})
+}
+
+func TestConvert_DisplayNameAnnotation(t *testing.T) {
+	tests := map[string]struct {
+		annotation string
+		issues     []ConversionIssue
+	}{
+		"same value": {
+			annotation: "Web",
+		},
+		"different value": {
+			annotation: "Web service",
+			issues: []ConversionIssue{{
+				ObjectName:   "v1.Service 'web'",
+				PropertyPath: "metadata.displayName",
+				Message: "v2alpha does not support display names," +
+					" it was removed since 'openslo.com/display-name' annotation already has a different value:" +
+					" 'Web service'",
+			}},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			service := v1.NewService(v1.Metadata{
+				Name:        "web",
+				DisplayName: "Web",
+				Annotations: v1.Annotations{DisplayNameAnnotation: test.annotation},
+			}, v1.ServiceSpec{})
+
+			objects, issues, err := Convert([]openslo.Object{service}, openslo.VersionV2alpha)
+			assert.Require(t, assert.NoError(t, err))
+			assert.Equal(t, test.issues, issues)
+			assert.Require(t, assert.Len(t, objects, 1))
+			assert.Equal(t, v2alpha.Annotations{DisplayNameAnnotation: test.annotation},
+				objects[0].(v2alpha.Service).Metadata.Annotations)
+		})
+	}
+}
+
+func TestConvert_EmptyConnectionDetails(t *testing.T) {
+	sli := v1.NewSLI(v1.Metadata{Name: "latency"}, v1.SLISpec{
+		ThresholdMetric: &v1.SLIMetricSpec{MetricSource: v1.SLIMetricSource{
+			Type: "Prometheus",
+			Spec: map[string]any{"query": "latency_seconds", "url": "http://prometheus.example.com"},
+		}},
+	})
+
+	converted, _, err := Convert([]openslo.Object{sli}, openslo.VersionV2alpha)
+	assert.Require(t, assert.NoError(t, err))
+	assert.Require(t, assert.Len(t, converted, 1))
+	assert.Equal(t, 0, len(converted[0].(v2alpha.SLI).Spec.ThresholdMetric.DataSourceSpec.ConnectionDetails))
+
+	restored, issues, err := Convert(converted, openslo.VersionV1)
+	assert.Require(t, assert.NoError(t, err))
+	assert.Len(t, issues, 0)
+	assert.Equal(t, []openslo.Object{sli}, restored)
+}
@@ -0,0 +1,303 @@
+package openslosdk
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nobl9/govy/pkg/jsonpath"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+func (c *converter) convertV1ToV2alpha(object openslo.Object) ([]openslo.Object, error) {
+	var converted openslo.Object
+	switch v := object.(type) {
+	case v1.Service:
+		converted = v2alpha.NewService(
+			c.convertV1Metadata(v.Metadata, "metadata"),
+			v2alpha.ServiceSpec{Description: v.Spec.Description},
+		)
+	case v1.DataSource:
+		converted = v2alpha.NewDataSource(
+			c.convertV1Metadata(v.Metadata, "metadata"),
+			v2alpha.DataSourceSpec{
+				Description:       v.Spec.Description,
+				Type:              v.Spec.Type,
+				ConnectionDetails: slices.Clone(v.Spec.ConnectionDetails),
+			},
+		)
+	case v1.SLI:
+		converted = v2alpha.NewSLI(
+			c.convertV1Metadata(v.Metadata, "metadata"),
+			c.convertV1SLISpec(v.Spec, "spec"),
+		)
+	case v1.SLO:
+		converted = v2alpha.NewSLO(
+			c.convertV1Metadata(v.Metadata, "metadata"),
+			c.convertV1SLOSpec(v.Spec),
+		)
+	case v1.AlertPolicy:
+		converted = v2alpha.NewAlertPolicy(
+			c.convertV1Metadata(v.Metadata, "metadata"),
+			c.convertV1AlertPolicySpec(v.Spec, "spec"),
+		)
+	case v1.AlertCondition:
+		converted = v2alpha.NewAlertCondition(
+			c.convertV1Metadata(v.Metadata, "metadata"),
+			c.convertV1AlertConditionSpec(v.Spec, "spec"),
+		)
+	case v1.AlertNotificationTarget:
+		converted = v2alpha.NewAlertNotificationTarget(
+			c.convertV1Metadata(v.Metadata, "metadata"),
+			v2alpha.AlertNotificationTargetSpec{
+				Description: v.Spec.Description,
+				Target:      v.Spec.Target,
+			},
+		)
+	default:
+		return nil, fmt.Errorf("unsupported %s object", object.GetKind())
+	}
+	return []openslo.Object{converted}, nil
+}
+
+// convertV1Metadata converts [v1.Metadata] into [v2alpha.Metadata].
+// Display name is moved to the [DisplayNameAnnotation] annotation, unless the annotation is already set,
+// and only the first value of multi-value labels is preserved.
+func (c *converter) convertV1Metadata(metadata v1.Metadata, path string) v2alpha.Metadata {
+	converted := v2alpha.Metadata{
+		Name:        metadata.Name,
+		Annotations: v2alpha.Annotations(maps.Clone(metadata.Annotations)),
+	}
+	if metadata.DisplayName != "" {
+		if converted.Annotations == nil {
+			converted.Annotations = make(v2alpha.Annotations, 1)
+		}
+		switch annotation, ok := converted.Annotations[DisplayNameAnnotation]; {
+		case !ok:
+			converted.Annotations[DisplayNameAnnotation] = metadata.DisplayName
+			c.report(path+".displayName", "v2alpha does not support display names,"+
+				" it was moved to '%s' annotation", DisplayNameAnnotation)
+		case annotation != metadata.DisplayName:
+			c.report(path+".displayName", "v2alpha does not support display names,"+
+				" it was removed since '%s' annotation already has a different value: '%s'",
+				DisplayNameAnnotation, annotation)
+		}
+	}
+	if len(metadata.Labels) == 0 {
+		return converted
+	}
+	converted.Labels = make(v2alpha.Labels, len(metadata.Labels))
+	for _, key := range slices.Sorted(maps.Keys(metadata.Labels)) {
+		values := metadata.Labels[key]
+		if len(values) == 0 {
+			converted.Labels[key] = ""
+			continue
+		}
+		converted.Labels[key] = values[0]
+		if len(values) > 1 {
+			c.report(jsonpath.Parse(path+".labels").Name(key).String(),
+				"v2alpha does not support multi-value labels, only the first value '%s' was kept out of: %s",
+				values[0], strings.Join(values, ", "))
+		}
+	}
+	return converted
+}
+
+func (c *converter) convertV1SLOSpec(spec v1.SLOSpec) v2alpha.SLOSpec {
+	converted := v2alpha.SLOSpec{
+		Description:     spec.Description,
+		ServiceRef:      spec.Service,
+		SLIRef:          clonePtr(spec.IndicatorRef),
+		SLI:             c.convertV1SLOIndicator(spec.Indicator, "spec.indicator"),
+		BudgetingMethod: v2alpha.SLOBudgetingMethod(spec.BudgetingMethod),
+	}
+	for i, timeWindow := range spec.TimeWindow {
+		path := fmt.Sprintf("spec.timeWindow[%d].duration", i)
+		converted.TimeWindow = append(converted.TimeWindow, v2alpha.SLOTimeWindow{
+			Duration:  c.convertV1DurationShorthand(timeWindow.Duration, path),
+			IsRolling: timeWindow.IsRolling,
+			Calendar:  (*v2alpha.SLOCalendar)(clonePtr(timeWindow.Calendar)),
+		})
+	}
+	for i, objective := range spec.Objectives {
+		path := fmt.Sprintf("spec.objectives[%d]", i)
+		convertedObjective := v2alpha.SLOObjective{
+			DisplayName:     objective.DisplayName,
+			Operator:        v2alpha.Operator(objective.Operator),
+			Value:           clonePtr(objective.Value),
+			Target:          clonePtr(objective.Target),
+			TargetPercent:   clonePtr(objective.TargetPercent),
+			TimeSliceTarget: clonePtr(objective.TimeSliceTarget),
+			SLI:             c.convertV1SLOIndicator(objective.Indicator, path+".indicator"),
+			SLIRef:          clonePtr(objective.IndicatorRef),
+			CompositeWeight: clonePtr(objective.CompositeWeight),
+		}
+		if objective.TimeSliceWindow != nil {
+			convertedObjective.TimeSliceWindow = ptr(c.convertV1DurationShorthand(
+				*objective.TimeSliceWindow,
+				path+".timeSliceWindow",
+			))
+		}
+		converted.Objectives = append(converted.Objectives, convertedObjective)
+	}
+	for i, alertPolicy := range spec.AlertPolicies {
+		var convertedAlertPolicy v2alpha.SLOAlertPolicy
+		if alertPolicy.SLOAlertPolicyRef != nil {
+			convertedAlertPolicy.SLOAlertPolicyRef = &v2alpha.SLOAlertPolicyRef{
+				AlertPolicyRef: alertPolicy.AlertPolicyRef,
+			}
+		}
+		if alertPolicy.SLOAlertPolicyInline != nil {
+			path := fmt.Sprintf("spec.alertPolicies[%d]", i)
+			convertedAlertPolicy.SLOAlertPolicyInline = &v2alpha.SLOAlertPolicyInline{
+				Kind:     alertPolicy.Kind,
+				Metadata: c.convertV1Metadata(alertPolicy.Metadata, path+".metadata"),
+				Spec:     c.convertV1AlertPolicySpec(alertPolicy.Spec, path+".spec"),
+			}
+		}
+		converted.AlertPolicies = append(converted.AlertPolicies, convertedAlertPolicy)
+	}
+	return converted
+}
+
+func (c *converter) convertV1SLOIndicator(indicator *v1.SLOIndicatorInline, path string) *v2alpha.SLOSLIInline {
+	if indicator == nil {
+		return nil
+	}
+	return &v2alpha.SLOSLIInline{
+		Metadata: c.convertV1Metadata(indicator.Metadata, path+".metadata"),
+		Spec:     c.convertV1SLISpec(indicator.Spec, path+".spec"),
+	}
+}
+
+func (c *converter) convertV1SLISpec(spec v1.SLISpec, path string) v2alpha.SLISpec {
+	converted := v2alpha.SLISpec{
+		Description:     spec.Description,
+		ThresholdMetric: c.convertV1SLIMetricSpec(spec.ThresholdMetric, path+".thresholdMetric"),
+	}
+	if spec.RatioMetric != nil {
+		path += ".ratioMetric"
+		converted.RatioMetric = &v2alpha.SLIRatioMetric{
+			Counter: spec.RatioMetric.Counter,
+			Good:    c.convertV1SLIMetricSpec(spec.RatioMetric.Good, path+".good"),
+			Bad:     c.convertV1SLIMetricSpec(spec.RatioMetric.Bad, path+".bad"),
+			Total:   c.convertV1SLIMetricSpec(spec.RatioMetric.Total, path+".total"),
+			RawType: v2alpha.SLIRawMetricType(spec.RatioMetric.RawType),
+			Raw:     c.convertV1SLIMetricSpec(spec.RatioMetric.Raw, path+".raw"),
+		}
+	}
+	return converted
+}
+
+// convertV1SLIMetricSpec converts [v1.SLIMetricSpec] into [v2alpha.SLIMetricSpec].
+// Metric source reference becomes a data source reference.
+// If no reference is set, metric source type becomes an inline data source spec,
+// the connection details are expected to be part of the metric spec.
+func (c *converter) convertV1SLIMetricSpec(spec *v1.SLIMetricSpec, path string) *v2alpha.SLIMetricSpec {
+	if spec == nil {
+		return nil
+	}
+	source := spec.MetricSource
+	converted := &v2alpha.SLIMetricSpec{
+		DataSourceRef: source.MetricSourceRef,
+		Spec:          maps.Clone(source.Spec),
+	}
+	switch {
+	case source.Type == "":
+	case source.MetricSourceRef != "":
+		c.report(path+".metricSource.type", "v2alpha infers the metric source type from the referenced DataSource,"+
+			" type '%s' was removed", source.Type)
+	default:
+		converted.DataSourceSpec = &v2alpha.DataSourceSpec{Type: source.Type}
+		c.report(path+".metricSource.spec", "v2alpha requires connection details of inline data sources,"+
+			" they must be moved from the metric source spec manually")
+	}
+	return converted
+}
+
+func (c *converter) convertV1AlertPolicySpec(spec v1.AlertPolicySpec, path string) v2alpha.AlertPolicySpec {
+	converted := v2alpha.AlertPolicySpec{
+		Description:        spec.Description,
+		AlertWhenNoData:    spec.AlertWhenNoData,
+		AlertWhenBreaching: spec.AlertWhenBreaching,
+		AlertWhenResolved:  spec.AlertWhenResolved,
+	}
+	for i, condition := range spec.Conditions {
+		var convertedCondition v2alpha.AlertPolicyCondition
+		if condition.AlertPolicyConditionRef != nil {
+			convertedCondition.AlertPolicyConditionRef = &v2alpha.AlertPolicyConditionRef{
+				ConditionRef: condition.ConditionRef,
+			}
+		}
+		if condition.AlertPolicyConditionInline != nil {
+			conditionPath := fmt.Sprintf("%s.conditions[%d]", path, i)
+			convertedCondition.AlertPolicyConditionInline = &v2alpha.AlertPolicyConditionInline{
+				Kind:     condition.Kind,
+				Metadata: c.convertV1Metadata(condition.Metadata, conditionPath+".metadata"),
+				Spec:     c.convertV1AlertConditionSpec(condition.Spec, conditionPath+".spec"),
+			}
+		}
+		converted.Conditions = append(converted.Conditions, convertedCondition)
+	}
+	for i, target := range spec.NotificationTargets {
+		var convertedTarget v2alpha.AlertPolicyNotificationTarget
+		if target.AlertPolicyNotificationTargetRef != nil {
+			convertedTarget.AlertPolicyNotificationTargetRef = &v2alpha.AlertPolicyNotificationTargetRef{
+				TargetRef: target.TargetRef,
+			}
+		}
+		if target.AlertPolicyNotificationTargetInline != nil {
+			targetPath := fmt.Sprintf("%s.notificationTargets[%d]", path, i)
+			convertedTarget.AlertPolicyNotificationTargetInline = &v2alpha.AlertPolicyNotificationTargetInline{
+				Kind:     target.Kind,
+				Metadata: c.convertV1Metadata(target.Metadata, targetPath+".metadata"),
+				Spec: v2alpha.AlertNotificationTargetSpec{
+					Description: target.Spec.Description,
+					Target:      target.Spec.Target,
+				},
+			}
+		}
+		converted.NotificationTargets = append(converted.NotificationTargets, convertedTarget)
+	}
+	return converted
+}
+
+func (c *converter) convertV1AlertConditionSpec(spec v1.AlertConditionSpec, path string) v2alpha.AlertConditionSpec {
+	path += ".condition"
+	converted := v2alpha.AlertConditionSpec{
+		Severity:    spec.Severity,
+		Description: spec.Description,
+		Condition: v2alpha.AlertConditionType{
+			Kind:           v2alpha.AlertConditionKind(spec.Condition.Kind),
+			Operator:       v2alpha.Operator(spec.Condition.Operator),
+			Threshold:      clonePtr(spec.Condition.Threshold),
+			LookbackWindow: c.convertV1DurationShorthand(spec.Condition.LookbackWindow, path+".lookbackWindow"),
+		},
+	}
+	if spec.Condition.AlertAfter != nil {
+		converted.Condition.AlertAfter = c.convertV1DurationShorthand(*spec.Condition.AlertAfter, path+".alertAfter")
+	} else {
+		c.report(path+".alertAfter", "alertAfter is required by v2alpha and has no default, it must be set manually")
+	}
+	return converted
+}
+
+// convertV1DurationShorthand converts [v1.DurationShorthand] into [v2alpha.DurationShorthand].
+// Month, quarter and year units are not supported by v2alpha,
+// these are converted to days, using the same approximation as [v1.DurationShorthand.Duration].
+func (c *converter) convertV1DurationShorthand(duration v1.DurationShorthand, path string) v2alpha.DurationShorthand {
+	unit := duration.GetUnit()
+	switch unit {
+	case v1.DurationShorthandUnitMonth, v1.DurationShorthandUnitQuarter, v1.DurationShorthandUnitYear:
+		days := int(duration.Duration() / (24 * time.Hour))
+		c.report(path, "v2alpha does not support '%s' unit, '%s' was approximated to '%dd'", unit, duration, days)
+		return v2alpha.NewDurationShorthand(days, v2alpha.DurationShorthandUnitDay)
+	default:
+		return v2alpha.NewDurationShorthand(duration.GetValue(), v2alpha.DurationShorthandUnit(unit))
+	}
+}
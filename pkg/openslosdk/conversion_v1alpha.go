@@ -0,0 +1,176 @@
+package openslosdk
+
+import (
+	"fmt"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v1alpha"
+)
+
+func (c *converter) convertV1alphaToV1(object openslo.Object) ([]openslo.Object, error) {
+	switch v := object.(type) {
+	case v1alpha.Service:
+		return []openslo.Object{
+			v1.NewService(convertV1alphaMetadata(v.Metadata), v1.ServiceSpec{Description: v.Spec.Description}),
+		}, nil
+	case v1alpha.SLO:
+		return c.convertV1alphaSLO(v), nil
+	default:
+		return nil, fmt.Errorf("unsupported %s object", object.GetKind())
+	}
+}
+
+// convertV1alphaSLO converts [v1alpha.SLO] into [v1.SLO] and the [v1.SLI] objects it references.
+// If all objectives share the same ratio metrics, or the SLO defines a threshold metric indicator,
+// a single [v1.SLI] named after the SLO is generated and referenced on the spec level.
+// If the objectives define different ratio metrics, an [v1.SLI] is generated for each of them
+// and referenced on the objective level, which results in a composite [v1.SLO].
+func (c *converter) convertV1alphaSLO(slo v1alpha.SLO) []openslo.Object {
+	spec := v1.SLOSpec{
+		Description:     slo.Spec.Description,
+		Service:         slo.Spec.Service,
+		BudgetingMethod: v1.SLOBudgetingMethod(slo.Spec.BudgetingMethod),
+		Objectives:      make([]v1.SLOObjective, 0, len(slo.Spec.Objectives)),
+	}
+	for i, timeWindow := range slo.Spec.TimeWindows {
+		spec.TimeWindow = append(spec.TimeWindow, c.convertV1alphaTimeWindow(
+			timeWindow,
+			fmt.Sprintf("spec.timeWindows[%d]", i),
+		))
+	}
+	for i, objective := range slo.Spec.Objectives {
+		spec.Objectives = append(spec.Objectives, c.convertV1alphaObjective(
+			objective,
+			slo.Spec.BudgetingMethod,
+			fmt.Sprintf("spec.objectives[%d]", i),
+		))
+	}
+
+	var slis []openslo.Object
+	newSLI := func(name string, sliSpec v1.SLISpec) string {
+		metadata := v1.Metadata{Name: name, DisplayName: slo.Metadata.DisplayName}
+		slis = append(slis, v1.NewSLI(metadata, sliSpec))
+		return name
+	}
+	switch {
+	case slo.Spec.Indicator != nil:
+		spec.IndicatorRef = ptr(newSLI(slo.Metadata.Name, v1.SLISpec{
+			ThresholdMetric: convertV1alphaMetricSourceSpec(slo.Spec.Indicator.ThresholdMetric),
+		}))
+	case hasUniformV1alphaRatioMetrics(slo.Spec.Objectives):
+		spec.IndicatorRef = ptr(newSLI(slo.Metadata.Name, v1.SLISpec{
+			RatioMetric: convertV1alphaRatioMetrics(*slo.Spec.Objectives[0].RatioMetrics),
+		}))
+	default:
+		c.report("spec.objectives", "objectives define different ratio metrics,"+
+			" each of them was converted into a separate v1.SLI which results in a composite v1.SLO")
+		for i, objective := range slo.Spec.Objectives {
+			if objective.RatioMetrics == nil {
+				c.report(fmt.Sprintf("spec.objectives[%d]", i), "objective has no ratio metrics to convert")
+				continue
+			}
+			spec.Objectives[i].IndicatorRef = ptr(newSLI(
+				fmt.Sprintf("%s-%d", slo.Metadata.Name, i),
+				v1.SLISpec{RatioMetric: convertV1alphaRatioMetrics(*objective.RatioMetrics)},
+			))
+		}
+	}
+	converted := v1.NewSLO(convertV1alphaMetadata(slo.Metadata), spec)
+	return append([]openslo.Object{converted}, slis...)
+}
+
+func (c *converter) convertV1alphaObjective(
+	objective v1alpha.SLOObjective,
+	budgetingMethod v1alpha.SLOBudgetingMethod,
+	path string,
+) v1.SLOObjective {
+	converted := v1.SLOObjective{
+		DisplayName:     objective.DisplayName,
+		Operator:        v1.Operator(objective.Operator),
+		Value:           objective.Value,
+		Target:          objective.BudgetTarget,
+		TimeSliceTarget: objective.TimeSliceTarget,
+	}
+	if objective.RatioMetrics != nil && objective.Value != nil {
+		converted.Value = nil
+		c.report(path+".value", "value is not supported by v1 for ratio metrics and was removed")
+	}
+	if budgetingMethod == v1alpha.SLOBudgetingMethodTimeslices {
+		c.report(path+".timeSliceWindow", "timeSliceWindow is required by v1 for %s budgeting method"+
+			" and has no v1alpha equivalent, it must be set manually", budgetingMethod)
+	}
+	return converted
+}
+
+func (c *converter) convertV1alphaTimeWindow(timeWindow v1alpha.SLOTimeWindow, path string) v1.SLOTimeWindow {
+	converted := v1.SLOTimeWindow{IsRolling: timeWindow.IsRolling}
+	if timeWindow.Calendar != nil {
+		converted.Calendar = &v1.SLOCalendar{
+			StartTime: timeWindow.Calendar.StartTime,
+			TimeZone:  timeWindow.Calendar.TimeZone,
+		}
+	}
+	switch timeWindow.Unit {
+	case v1alpha.SLOTimeWindowUnitSecond:
+		minutes := (timeWindow.Count + 59) / 60
+		if timeWindow.Count%60 != 0 {
+			c.report(path+".count", "v1 does not support second precision,"+
+				" %d seconds were rounded up to %d minutes", timeWindow.Count, minutes)
+		}
+		converted.Duration = v1.NewDurationShorthand(minutes, v1.DurationShorthandUnitMinute)
+	case v1alpha.SLOTimeWindowUnitDay:
+		converted.Duration = v1.NewDurationShorthand(timeWindow.Count, v1.DurationShorthandUnitDay)
+	case v1alpha.SLOTimeWindowUnitWeek:
+		converted.Duration = v1.NewDurationShorthand(timeWindow.Count, v1.DurationShorthandUnitWeek)
+	case v1alpha.SLOTimeWindowUnitMonth:
+		converted.Duration = v1.NewDurationShorthand(timeWindow.Count, v1.DurationShorthandUnitMonth)
+	case v1alpha.SLOTimeWindowUnitQuarter:
+		converted.Duration = v1.NewDurationShorthand(timeWindow.Count, v1.DurationShorthandUnitQuarter)
+	default:
+		c.report(path+".unit", "unsupported time window unit '%s' could not be converted", timeWindow.Unit)
+	}
+	return converted
+}
+
+func convertV1alphaMetadata(metadata v1alpha.Metadata) v1.Metadata {
+	return v1.Metadata{
+		Name:        metadata.Name,
+		DisplayName: metadata.DisplayName,
+	}
+}
+
+func convertV1alphaRatioMetrics(ratio v1alpha.SLORatioMetrics) *v1.SLIRatioMetric {
+	return &v1.SLIRatioMetric{
+		Counter: ratio.Incremental,
+		Good:    convertV1alphaMetricSourceSpec(ratio.Good),
+		Total:   convertV1alphaMetricSourceSpec(ratio.Total),
+	}
+}
+
+// convertV1alphaMetricSourceSpec converts [v1alpha.SLOMetricSourceSpec] into [v1.SLIMetricSpec].
+// The source becomes the metric source type, while the query and its type are moved into the metric source spec.
+func convertV1alphaMetricSourceSpec(spec v1alpha.SLOMetricSourceSpec) *v1.SLIMetricSpec {
+	return &v1.SLIMetricSpec{
+		MetricSource: v1.SLIMetricSource{
+			Type: spec.Source,
+			Spec: map[string]any{
+				"queryType": spec.QueryType,
+				"query":     spec.Query,
+			},
+		},
+	}
+}
+
+// hasUniformV1alphaRatioMetrics returns true if all the objectives define the same ratio metrics.
+func hasUniformV1alphaRatioMetrics(objectives []v1alpha.SLOObjective) bool {
+	if len(objectives) == 0 || objectives[0].RatioMetrics == nil {
+		return false
+	}
+	for _, objective := range objectives[1:] {
+		if objective.RatioMetrics == nil || *objective.RatioMetrics != *objectives[0].RatioMetrics {
+			return false
+		}
+	}
+	return true
+}
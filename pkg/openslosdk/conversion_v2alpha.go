@@ -0,0 +1,307 @@
+package openslosdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+func (c *converter) convertV2alphaToV1(object openslo.Object) ([]openslo.Object, error) {
+	var converted openslo.Object
+	switch v := object.(type) {
+	case v2alpha.Service:
+		converted = v1.NewService(
+			convertV2alphaMetadata(v.Metadata),
+			v1.ServiceSpec{Description: v.Spec.Description},
+		)
+	case v2alpha.DataSource:
+		converted = v1.NewDataSource(
+			convertV2alphaMetadata(v.Metadata),
+			v1.DataSourceSpec{
+				Description:       v.Spec.Description,
+				Type:              v.Spec.Type,
+				ConnectionDetails: slices.Clone(v.Spec.ConnectionDetails),
+			},
+		)
+	case v2alpha.SLI:
+		spec, err := c.convertV2alphaSLISpec(v.Spec, "spec")
+		if err != nil {
+			return nil, err
+		}
+		converted = v1.NewSLI(convertV2alphaMetadata(v.Metadata), spec)
+	case v2alpha.SLO:
+		spec, err := c.convertV2alphaSLOSpec(v.Spec)
+		if err != nil {
+			return nil, err
+		}
+		converted = v1.NewSLO(convertV2alphaMetadata(v.Metadata), spec)
+	case v2alpha.AlertPolicy:
+		converted = v1.NewAlertPolicy(
+			convertV2alphaMetadata(v.Metadata),
+			convertV2alphaAlertPolicySpec(v.Spec),
+		)
+	case v2alpha.AlertCondition:
+		converted = v1.NewAlertCondition(
+			convertV2alphaMetadata(v.Metadata),
+			convertV2alphaAlertConditionSpec(v.Spec),
+		)
+	case v2alpha.AlertNotificationTarget:
+		converted = v1.NewAlertNotificationTarget(
+			convertV2alphaMetadata(v.Metadata),
+			v1.AlertNotificationTargetSpec{
+				Description: v.Spec.Description,
+				Target:      v.Spec.Target,
+			},
+		)
+	default:
+		return nil, fmt.Errorf("unsupported %s object", object.GetKind())
+	}
+	return []openslo.Object{converted}, nil
+}
+
+// convertV2alphaMetadata converts [v2alpha.Metadata] into [v1.Metadata].
+// If the [DisplayNameAnnotation] annotation is set, it's moved back to the display name.
+func convertV2alphaMetadata(metadata v2alpha.Metadata) v1.Metadata {
+	converted := v1.Metadata{
+		Name:        metadata.Name,
+		Annotations: v1.Annotations(maps.Clone(metadata.Annotations)),
+	}
+	if displayName, ok := converted.Annotations[DisplayNameAnnotation]; ok {
+		converted.DisplayName = displayName
+		delete(converted.Annotations, DisplayNameAnnotation)
+		if len(converted.Annotations) == 0 {
+			converted.Annotations = nil
+		}
+	}
+	if len(metadata.Labels) > 0 {
+		converted.Labels = make(v1.Labels, len(metadata.Labels))
+		for key, value := range metadata.Labels {
+			converted.Labels[key] = v1.Label{value}
+		}
+	}
+	return converted
+}
+
+func (c *converter) convertV2alphaSLOSpec(spec v2alpha.SLOSpec) (v1.SLOSpec, error) {
+	converted := v1.SLOSpec{
+		Description:     spec.Description,
+		Service:         spec.ServiceRef,
+		IndicatorRef:    clonePtr(spec.SLIRef),
+		BudgetingMethod: v1.SLOBudgetingMethod(spec.BudgetingMethod),
+	}
+	var err error
+	converted.Indicator, err = c.convertV2alphaSLOSLI(spec.SLI, "spec.sli")
+	if err != nil {
+		return v1.SLOSpec{}, err
+	}
+	for _, timeWindow := range spec.TimeWindow {
+		converted.TimeWindow = append(converted.TimeWindow, v1.SLOTimeWindow{
+			Duration:  convertV2alphaDurationShorthand(timeWindow.Duration),
+			IsRolling: timeWindow.IsRolling,
+			Calendar:  (*v1.SLOCalendar)(clonePtr(timeWindow.Calendar)),
+		})
+	}
+	for i, objective := range spec.Objectives {
+		convertedObjective := v1.SLOObjective{
+			DisplayName:     objective.DisplayName,
+			Operator:        v1.Operator(objective.Operator),
+			Value:           clonePtr(objective.Value),
+			Target:          clonePtr(objective.Target),
+			TargetPercent:   clonePtr(objective.TargetPercent),
+			TimeSliceTarget: clonePtr(objective.TimeSliceTarget),
+			IndicatorRef:    clonePtr(objective.SLIRef),
+			CompositeWeight: clonePtr(objective.CompositeWeight),
+		}
+		if objective.TimeSliceWindow != nil {
+			convertedObjective.TimeSliceWindow = ptr(convertV2alphaDurationShorthand(*objective.TimeSliceWindow))
+		}
+		convertedObjective.Indicator, err = c.convertV2alphaSLOSLI(
+			objective.SLI,
+			fmt.Sprintf("spec.objectives[%d].sli", i),
+		)
+		if err != nil {
+			return v1.SLOSpec{}, err
+		}
+		converted.Objectives = append(converted.Objectives, convertedObjective)
+	}
+	for _, alertPolicy := range spec.AlertPolicies {
+		var convertedAlertPolicy v1.SLOAlertPolicy
+		if alertPolicy.SLOAlertPolicyRef != nil {
+			convertedAlertPolicy.SLOAlertPolicyRef = &v1.SLOAlertPolicyRef{
+				AlertPolicyRef: alertPolicy.AlertPolicyRef,
+			}
+		}
+		if alertPolicy.SLOAlertPolicyInline != nil {
+			convertedAlertPolicy.SLOAlertPolicyInline = &v1.SLOAlertPolicyInline{
+				Kind:     alertPolicy.Kind,
+				Metadata: convertV2alphaMetadata(alertPolicy.Metadata),
+				Spec:     convertV2alphaAlertPolicySpec(alertPolicy.Spec),
+			}
+		}
+		converted.AlertPolicies = append(converted.AlertPolicies, convertedAlertPolicy)
+	}
+	return converted, nil
+}
+
+func (c *converter) convertV2alphaSLOSLI(sli *v2alpha.SLOSLIInline, path string) (*v1.SLOIndicatorInline, error) {
+	if sli == nil {
+		return nil, nil
+	}
+	spec, err := c.convertV2alphaSLISpec(sli.Spec, path+".spec")
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SLOIndicatorInline{
+		Metadata: convertV2alphaMetadata(sli.Metadata),
+		Spec:     spec,
+	}, nil
+}
+
+func (c *converter) convertV2alphaSLISpec(spec v2alpha.SLISpec, path string) (v1.SLISpec, error) {
+	converted := v1.SLISpec{Description: spec.Description}
+	if spec.RatioMetric != nil {
+		converted.RatioMetric = &v1.SLIRatioMetric{
+			Counter: spec.RatioMetric.Counter,
+			RawType: v1.SLIRawMetricType(spec.RatioMetric.RawType),
+		}
+	}
+	for _, metric := range getV2alphaSLIMetricSpecs(&spec) {
+		metricPath := path + strings.TrimPrefix(metric.path, "spec")
+		convertedMetric, err := c.convertV2alphaSLIMetricSpec(*metric.spec, metricPath)
+		if err != nil {
+			return v1.SLISpec{}, err
+		}
+		switch metric.name {
+		case "threshold":
+			converted.ThresholdMetric = convertedMetric
+		case "good":
+			converted.RatioMetric.Good = convertedMetric
+		case "bad":
+			converted.RatioMetric.Bad = convertedMetric
+		case "total":
+			converted.RatioMetric.Total = convertedMetric
+		case "raw":
+			converted.RatioMetric.Raw = convertedMetric
+		}
+	}
+	return converted, nil
+}
+
+// convertV2alphaSLIMetricSpec converts [v2alpha.SLIMetricSpec] into [v1.SLIMetricSpec].
+// Data source reference becomes a metric source reference.
+// Since v1 does not support inline data sources, inline data source spec is merged into the metric source,
+// the same way [ReferenceInliner] does it for [v1.DataSource] references.
+func (c *converter) convertV2alphaSLIMetricSpec(spec *v2alpha.SLIMetricSpec, path string) (*v1.SLIMetricSpec, error) {
+	converted := &v1.SLIMetricSpec{
+		MetricSource: v1.SLIMetricSource{
+			MetricSourceRef: spec.DataSourceRef,
+			Spec:            maps.Clone(spec.Spec),
+		},
+	}
+	if spec.DataSourceSpec == nil {
+		return converted, nil
+	}
+	converted.MetricSource.Type = spec.DataSourceSpec.Type
+	if !isEmptyConnectionDetails(spec.DataSourceSpec.ConnectionDetails) {
+		merged, err := mergeConnectionDetails(spec.Spec, spec.DataSourceSpec.ConnectionDetails)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert '%s.dataSourceSpec': %w", path, err)
+		}
+		converted.MetricSource.Spec = merged
+		c.report(path+".dataSourceSpec.connectionDetails", "v1 does not support inline data sources,"+
+			" connection details were merged into the metric source spec")
+	}
+	if spec.DataSourceSpec.Description != "" {
+		c.report(path+".dataSourceSpec.description", "v1 does not support inline data sources,"+
+			" description was removed")
+	}
+	return converted, nil
+}
+
+func convertV2alphaAlertPolicySpec(spec v2alpha.AlertPolicySpec) v1.AlertPolicySpec {
+	converted := v1.AlertPolicySpec{
+		Description:        spec.Description,
+		AlertWhenNoData:    spec.AlertWhenNoData,
+		AlertWhenBreaching: spec.AlertWhenBreaching,
+		AlertWhenResolved:  spec.AlertWhenResolved,
+	}
+	for _, condition := range spec.Conditions {
+		var convertedCondition v1.AlertPolicyCondition
+		if condition.AlertPolicyConditionRef != nil {
+			convertedCondition.AlertPolicyConditionRef = &v1.AlertPolicyConditionRef{
+				ConditionRef: condition.ConditionRef,
+			}
+		}
+		if condition.AlertPolicyConditionInline != nil {
+			convertedCondition.AlertPolicyConditionInline = &v1.AlertPolicyConditionInline{
+				Kind:     condition.Kind,
+				Metadata: convertV2alphaMetadata(condition.Metadata),
+				Spec:     convertV2alphaAlertConditionSpec(condition.Spec),
+			}
+		}
+		converted.Conditions = append(converted.Conditions, convertedCondition)
+	}
+	for _, target := range spec.NotificationTargets {
+		var convertedTarget v1.AlertPolicyNotificationTarget
+		if target.AlertPolicyNotificationTargetRef != nil {
+			convertedTarget.AlertPolicyNotificationTargetRef = &v1.AlertPolicyNotificationTargetRef{
+				TargetRef: target.TargetRef,
+			}
+		}
+		if target.AlertPolicyNotificationTargetInline != nil {
+			convertedTarget.AlertPolicyNotificationTargetInline = &v1.AlertPolicyNotificationTargetInline{
+				Kind:     target.Kind,
+				Metadata: convertV2alphaMetadata(target.Metadata),
+				Spec: v1.AlertNotificationTargetSpec{
+					Description: target.Spec.Description,
+					Target:      target.Spec.Target,
+				},
+			}
+		}
+		converted.NotificationTargets = append(converted.NotificationTargets, convertedTarget)
+	}
+	return converted
+}
+
+func convertV2alphaAlertConditionSpec(spec v2alpha.AlertConditionSpec) v1.AlertConditionSpec {
+	converted := v1.AlertConditionSpec{
+		Severity:    spec.Severity,
+		Description: spec.Description,
+		Condition: v1.AlertConditionType{
+			Kind:           v1.AlertConditionKind(spec.Condition.Kind),
+			Operator:       v1.Operator(spec.Condition.Operator),
+			Threshold:      clonePtr(spec.Condition.Threshold),
+			LookbackWindow: convertV2alphaDurationShorthand(spec.Condition.LookbackWindow),
+		},
+	}
+	if alertAfter := convertV2alphaDurationShorthand(spec.Condition.AlertAfter); alertAfter.GetValue() != 0 {
+		converted.Condition.AlertAfter = &alertAfter
+	}
+	return converted
+}
+
+func convertV2alphaDurationShorthand(duration v2alpha.DurationShorthand) v1.DurationShorthand {
+	return v1.NewDurationShorthand(duration.GetValue(), v1.DurationShorthandUnit(duration.GetUnit()))
+}
+
+// isEmptyConnectionDetails returns true if the connection details are not set, 'null' or an empty object.
+func isEmptyConnectionDetails(details json.RawMessage) bool {
+	if len(details) == 0 {
+		return true
+	}
+	var decoded any
+	if err := json.Unmarshal(details, &decoded); err != nil {
+		return false
+	}
+	if decoded == nil {
+		return true
+	}
+	object, ok := decoded.(map[string]any)
+	return ok && len(object) == 0
+}
@@ -0,0 +1,477 @@
+package openslosdk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+)
+
+// NewDecoder creates a new [Decoder] which reads objects from [io.Reader],
+// according to the provided [ObjectFormat].
+func NewDecoder(r io.Reader, format ObjectFormat) *Decoder {
+	counter := &lineCounter{r: r, line: 1}
+	return &Decoder{
+		r:       bufio.NewReader(counter),
+		counter: counter,
+		format:  format,
+		line:    1,
+	}
+}
+
+// Decoder decodes a stream of [openslo.Object], one object at a time.
+// Unlike [Decode], it does not read the whole input upfront,
+// which allows processing large inputs with bounded memory.
+//
+// For YAML, the input may consist of multiple documents, each being either a single object or a list of objects.
+// Block sequences are decoded one element at a time, while flow sequences (e.g. '[{...}]')
+// are decoded as a whole document.
+// For JSON, the input may be either a single array of objects or a stream of objects.
+// For JSON Lines, every non-empty line must hold a single object.
+//
+// The origin of every decoded object is tracked and can be retrieved with [Decoder.Position].
+// Errors encountered while decoding an object are returned as [*DecodeError].
+type Decoder struct {
+	r                *bufio.Reader
+	counter          *lineCounter
+	format           ObjectFormat
+	fileName         string
+	nonOpenSLOPolicy NonOpenSLOPolicy
+	options          DecodeOptions
+	err              error
+	position         Position
+	index            int
+
+	// JSON state.
+	json        *json.Decoder
+	jsonBase    int64
+	inJSONArray bool
+	document    int
+
+	// YAML state.
+	queue          []genericObject
+	pendingLine    []byte
+	pendingLinePos Position
+	line           int
+	offset         int64
+	inSequence     bool
+	seqIndent      int
+	hasContent     bool
+}
+
+// NonOpenSLOPolicy defines how [Decoder] handles documents which are not OpenSLO objects,
+// that is, their 'apiVersion' does not belong to the OpenSLO specification, e.g. 'apps/v1'.
+// Versions registered with [openslo.RegisterObject] are treated as OpenSLO objects.
+type NonOpenSLOPolicy int
+
+const (
+	// NonOpenSLOPolicyError returns an error when a non-OpenSLO document is encountered.
+	NonOpenSLOPolicyError NonOpenSLOPolicy = iota
+	// NonOpenSLOPolicySkip silently skips non-OpenSLO documents.
+	NonOpenSLOPolicySkip
+)
+
+// WithNonOpenSLOPolicy sets the [NonOpenSLOPolicy], by default [NonOpenSLOPolicyError] is used.
+func (d *Decoder) WithNonOpenSLOPolicy(policy NonOpenSLOPolicy) *Decoder {
+	d.nonOpenSLOPolicy = policy
+	return d
+}
+
+// DecodeOptions configures how [Decoder] decodes objects.
+// The zero value represents the default, strict behavior.
+type DecodeOptions struct {
+	// AllowUnknownFields makes the decoder ignore unknown fields instead of returning an error.
+	AllowUnknownFields bool
+	// ContinueOnError makes the decoder continue with the next object after an object fails to decode.
+	// Malformed input which prevents locating the next object (e.g. invalid JSON syntax) still stops the decoding.
+	ContinueOnError bool
+	// SkipUnsupported makes the decoder skip OpenSLO documents with an unsupported 'apiVersion' or 'kind',
+	// e.g. 'openslo/v1' 'Unknown'.
+	// Documents which are not OpenSLO objects at all are handled according to the [NonOpenSLOPolicy].
+	SkipUnsupported bool
+}
+
+// WithOptions sets the [DecodeOptions].
+func (d *Decoder) WithOptions(options DecodeOptions) *Decoder {
+	d.options = options
+	return d
+}
+
+// WithFileName sets the file name reported in [Position] and [DecodeError].
+func (d *Decoder) WithFileName(name string) *Decoder {
+	d.fileName = name
+	return d
+}
+
+// Position returns the [Position] of the object most recently returned by [Decoder.Next].
+func (d *Decoder) Position() Position {
+	return d.position
+}
+
+// Next decodes the next [openslo.Object] from the input.
+// It returns [io.EOF] once there are no more objects to decode.
+// Once an error is returned, every subsequent call returns the same error,
+// unless [DecodeOptions.ContinueOnError] is set and the error concerns only a single object.
+func (d *Decoder) Next() (openslo.Object, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	if err := d.format.Validate(); err != nil {
+		d.err = err
+		return nil, err
+	}
+	object, err := d.next()
+	if err == nil {
+		d.index++
+		return object, nil
+	}
+	if errors.Is(err, io.EOF) {
+		d.err = err
+		return nil, err
+	}
+	var objErr *objectError
+	recoverable := errors.As(err, &objErr)
+	if recoverable {
+		err = objErr.err
+	}
+	err = &DecodeError{Position: d.position, Err: err}
+	if !recoverable || !d.options.ContinueOnError {
+		d.err = err
+	}
+	return nil, err
+}
+
+func (d *Decoder) next() (openslo.Object, error) {
+	for {
+		var (
+			generic *genericObject
+			err     error
+		)
+		switch d.format {
+		case FormatYAML:
+			generic, err = d.nextYAML()
+		case FormatJSON:
+			generic, err = d.nextJSON()
+		case FormatJSONLines:
+			generic, err = d.nextJSONLine()
+		default:
+			return nil, fmt.Errorf("unsupported %[1]T: %[1]s", d.format)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if generic.foreign && d.nonOpenSLOPolicy == NonOpenSLOPolicySkip {
+			continue
+		}
+		object, err := decodeGenericObject(*generic, !d.options.AllowUnknownFields)
+		if err != nil {
+			var unsupportedErr *unsupportedObjectError
+			if d.options.SkipUnsupported && !generic.foreign && errors.As(err, &unsupportedErr) {
+				continue
+			}
+			return nil, &objectError{err: err}
+		}
+		return object, nil
+	}
+}
+
+// objectError wraps errors which concern a single object and do not affect the rest of the stream.
+type objectError struct {
+	err error
+}
+
+func (e *objectError) Error() string {
+	return e.err.Error()
+}
+
+func (e *objectError) Unwrap() error {
+	return e.err
+}
+
+func (d *Decoder) nextJSON() (*genericObject, error) {
+	if d.json == nil {
+		first, err := d.peekNonSpace()
+		if err != nil {
+			return nil, err
+		}
+		d.json = json.NewDecoder(d.r)
+		if first == '[' {
+			if _, err = d.json.Token(); err != nil {
+				return nil, err
+			}
+			d.inJSONArray = true
+		}
+	}
+	if d.inJSONArray && !d.json.More() {
+		d.setJSONPosition(d.jsonBase + d.json.InputOffset())
+		// Consume the closing bracket and make sure nothing follows it.
+		if _, err := d.json.Token(); err != nil {
+			return nil, err
+		}
+		if _, err := d.json.Token(); !errors.Is(err, io.EOF) {
+			return nil, errors.New("unexpected data after JSON array")
+		}
+		d.inJSONArray = false
+		return nil, io.EOF
+	}
+	d.setJSONPosition(d.jsonBase + d.json.InputOffset())
+	var object genericObject
+	if err := d.json.Decode(&object); err != nil {
+		return nil, err
+	}
+	// The raw object data does not include the leading whitespace, which allows computing the exact start offset.
+	d.setJSONPosition(d.jsonBase + d.json.InputOffset() - int64(len(object.data)))
+	if !d.inJSONArray {
+		d.document++
+	}
+	return &object, nil
+}
+
+// nextJSONLine decodes the next non-empty line of the JSON Lines input.
+// Since every line is decoded on its own, a malformed line does not prevent decoding the following lines.
+func (d *Decoder) nextJSONLine() (*genericObject, error) {
+	for {
+		line, pos, err := d.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		d.position = pos
+		d.document++
+		var object genericObject
+		if err = json.Unmarshal(line, &object); err != nil {
+			return nil, &objectError{err: err}
+		}
+		return &object, nil
+	}
+}
+
+func (d *Decoder) setJSONPosition(offset int64) {
+	d.position = Position{
+		File:     d.fileName,
+		Document: d.document,
+		Index:    d.index,
+		Offset:   offset,
+		Line:     d.counter.lineAt(offset),
+	}
+}
+
+// peekNonSpace skips leading whitespace and returns the first non-whitespace byte without consuming it.
+func (d *Decoder) peekNonSpace() (byte, error) {
+	for {
+		b, err := d.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			d.jsonBase++
+			continue
+		}
+		return b, d.r.UnreadByte()
+	}
+}
+
+func (d *Decoder) nextYAML() (*genericObject, error) {
+	for {
+		if len(d.queue) > 0 {
+			object := d.queue[0]
+			d.queue = d.queue[1:]
+			d.position.Index = d.index
+			return &object, nil
+		}
+		if d.inSequence {
+			element, err := d.readSequenceElement()
+			if err != nil {
+				return nil, err
+			}
+			if len(bytes.TrimSpace(element)) == 0 {
+				continue
+			}
+			var object genericObject
+			if err = yaml.Unmarshal(element, &object); err != nil {
+				return nil, &objectError{err: err}
+			}
+			return &object, nil
+		}
+		line, pos, err := d.readLine()
+		if err != nil {
+			return nil, err
+		}
+		trimmed := bytes.TrimSpace(line)
+		if isYAMLDocumentSeparator(line) {
+			d.endDocument()
+			continue
+		}
+		if len(trimmed) == 0 || trimmed[0] == '#' {
+			continue
+		}
+		d.hasContent = true
+		if trimmed[0] == '-' {
+			d.inSequence = true
+			d.seqIndent = len(line) - len(bytes.TrimLeft(line, " "))
+			d.pendingLine, d.pendingLinePos = line, pos
+			continue
+		}
+		d.position = pos
+		doc, err := d.readDocument(line)
+		if err != nil {
+			return nil, err
+		}
+		if trimmed[0] == '[' {
+			if err = yaml.Unmarshal(doc, &d.queue); err != nil {
+				return nil, &objectError{err: err}
+			}
+			continue
+		}
+		var object genericObject
+		if err = yaml.Unmarshal(doc, &object); err != nil {
+			return nil, &objectError{err: err}
+		}
+		return &object, nil
+	}
+}
+
+// readDocument reads the remainder of the current YAML document, starting with the provided line.
+func (d *Decoder) readDocument(first []byte) ([]byte, error) {
+	doc := bytes.Clone(first)
+	for {
+		line, _, err := d.readLine()
+		if errors.Is(err, io.EOF) {
+			return doc, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if isYAMLDocumentSeparator(line) {
+			d.endDocument()
+			return doc, nil
+		}
+		doc = append(doc, line...)
+	}
+}
+
+// readSequenceElement reads a single element of the YAML block sequence.
+// The element starts at the pending line and ends before the next line which begins
+// with a dash at the sequence indentation, a document separator or EOF.
+// The dash of the first line is replaced with a space,
+// so that the element can be decoded as a standalone YAML document.
+func (d *Decoder) readSequenceElement() ([]byte, error) {
+	first, pos, err := d.readLine()
+	if err != nil {
+		return nil, err
+	}
+	d.position = pos
+	element := bytes.Clone(first)
+	element[d.seqIndent] = ' '
+	for {
+		line, pos, err := d.readLine()
+		if errors.Is(err, io.EOF) {
+			d.inSequence = false
+			return element, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if isYAMLDocumentSeparator(line) {
+			d.inSequence = false
+			d.endDocument()
+			return element, nil
+		}
+		if d.isSequenceEntry(line) {
+			d.pendingLine, d.pendingLinePos = line, pos
+			return element, nil
+		}
+		element = append(element, line...)
+	}
+}
+
+func (d *Decoder) isSequenceEntry(line []byte) bool {
+	return len(line) > d.seqIndent &&
+		line[d.seqIndent] == '-' &&
+		len(bytes.TrimLeft(line[:d.seqIndent], " ")) == 0
+}
+
+// endDocument is called when a document separator is encountered.
+// Separators which are not preceded by any content do not start a new document.
+func (d *Decoder) endDocument() {
+	if d.hasContent {
+		d.document++
+		d.hasContent = false
+	}
+}
+
+// readLine returns the next line, including the trailing newline, along with its [Position].
+// If a line was pushed back with pendingLine, it is returned first.
+func (d *Decoder) readLine() ([]byte, Position, error) {
+	if d.pendingLine != nil {
+		line, pos := d.pendingLine, d.pendingLinePos
+		d.pendingLine = nil
+		pos.Index = d.index
+		return line, pos, nil
+	}
+	pos := Position{
+		File:     d.fileName,
+		Document: d.document,
+		Index:    d.index,
+		Offset:   d.offset,
+		Line:     d.line,
+	}
+	line, err := d.r.ReadBytes('\n')
+	d.offset += int64(len(line))
+	d.line++
+	if len(line) > 0 && errors.Is(err, io.EOF) {
+		return append(line, '\n'), pos, nil
+	}
+	return line, pos, err
+}
+
+func isYAMLDocumentSeparator(line []byte) bool {
+	if !bytes.HasPrefix(line, []byte("---")) {
+		return false
+	}
+	rest := line[3:]
+	return len(rest) == 0 || rest[0] == ' ' || rest[0] == '\t' || rest[0] == '\r' || rest[0] == '\n'
+}
+
+// lineCounter is an [io.Reader] which tracks the offsets of newlines in the data read through it,
+// it is used to compute line numbers for offsets reported by [json.Decoder].
+// Only the offsets of newlines which have not yet been passed by [lineCounter.lineAt] are retained,
+// which means the memory usage is bounded by the read-ahead buffer size.
+type lineCounter struct {
+	r        io.Reader
+	read     int64
+	newlines []int64
+	line     int
+}
+
+func (c *lineCounter) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	for i, b := range p[:n] {
+		if b == '\n' {
+			c.newlines = append(c.newlines, c.read+int64(i))
+		}
+	}
+	c.read += int64(n)
+	return n, err
+}
+
+// lineAt returns the one-based line number of the provided offset.
+// Subsequent calls must provide non-decreasing offsets.
+func (c *lineCounter) lineAt(offset int64) int {
+	i := 0
+	for i < len(c.newlines) && c.newlines[i] < offset {
+		i++
+	}
+	c.line += i
+	c.newlines = c.newlines[i:]
+	return c.line
+}
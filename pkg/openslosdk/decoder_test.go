@@ -0,0 +1,373 @@
+package openslosdk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+)
+
+func TestDecoder_Next(t *testing.T) {
+	tests := map[string]struct {
+		testDataFile string
+		names        []string
+	}{
+		"YAML mixed documents": {
+			testDataFile: "decode/mixed_documents.yaml",
+			names: []string{
+				"service-1", "service-2", "service-3", "service-4",
+				"service-5", "service-6", "service-7",
+			},
+		},
+		"YAML two documents": {
+			testDataFile: "decode/two_documents.yaml",
+			names:        []string{"users-auth", "users-login"},
+		},
+		"JSON sequence of objects": {
+			testDataFile: "decode/sequence_of_objects.json",
+			names:        []string{"users-auth", "users-login"},
+		},
+		"JSON single object": {
+			testDataFile: "decode/single_object.json",
+			names:        []string{"users-auth"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := readTestData(t, testData, tc.testDataFile)
+			dec := NewDecoder(bytes.NewReader(data), getFileFormat(tc.testDataFile))
+
+			var names []string
+			for {
+				object, err := dec.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				assert.Require(t, assert.NoError(t, err))
+				names = append(names, object.GetName())
+			}
+			assert.Equal(t, tc.names, names)
+
+			_, err := dec.Next()
+			assert.True(t, errors.Is(err, io.EOF))
+		})
+	}
+}
+
+func TestDecoder_Next_Multiline(t *testing.T) {
+	dec := NewDecoder(bytes.NewReader(readTestData(t, testData, "decode/mixed_documents.yaml")), FormatYAML)
+	object, err := dec.Next()
+	assert.Require(t, assert.NoError(t, err))
+	service, ok := object.(v1.Service)
+	assert.Require(t, assert.True(t, ok))
+	assert.Equal(t, "Multiline\n- description\n", service.Spec.Description)
+}
+
+func TestDecoder_Next_JSONStream(t *testing.T) {
+	data := `{"apiVersion": "openslo/v1", "kind": "Service", "metadata": {"name": "service-1"}}
+{"apiVersion": "openslo/v1", "kind": "Service", "metadata": {"name": "service-2"}}`
+	objects := decodeAll(t, NewDecoder(strings.NewReader(data), FormatJSON))
+	assert.Len(t, objects, 2)
+}
+
+func TestDecoder_Next_JSONLines(t *testing.T) {
+	data := `{"apiVersion": "openslo/v1", "kind": "Service", "metadata": {"name": "service-1"}}
+
+{"apiVersion": "openslo/v1", "kind": "Service", "metadata": {"name": "service-2"
+{"apiVersion": "openslo/v1", "kind": "Service", "metadata": {"name": "service-3"}}
+`
+	dec := NewDecoder(strings.NewReader(data), FormatJSONLines).
+		WithOptions(DecodeOptions{ContinueOnError: true})
+
+	object, err := dec.Next()
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, "service-1", object.GetName())
+
+	_, err = dec.Next()
+	assert.Require(t, assert.Error(t, err))
+	assert.Equal(t, "line 3: unexpected end of JSON input", err.Error())
+
+	object, err = dec.Next()
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, "service-3", object.GetName())
+	assert.Equal(t, Position{
+		Document: 2,
+		Index:    1,
+		Offset:   lineOffset([]byte(data), 4),
+		Line:     4,
+	}, dec.Position())
+
+	_, err = dec.Next()
+	assert.True(t, errors.Is(err, io.EOF))
+}
+
+func TestDecoder_Next_Errors(t *testing.T) {
+	t.Run("error is returned on every subsequent call", func(t *testing.T) {
+		data := "- apiVersion: openslo/v1\n  kind: Unknown\n- apiVersion: openslo/v1\n  kind: Service\n"
+		dec := NewDecoder(strings.NewReader(data), FormatYAML)
+		_, err := dec.Next()
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "line 1: failed to decode openslo/v1 Unknown:"+
+			" unsupported openslo.Kind: Unknown for version: openslo/v1", err.Error())
+		_, nextErr := dec.Next()
+		assert.Equal(t, err, nextErr)
+	})
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := NewDecoder(strings.NewReader(""), 0).Next()
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "unsupported openslosdk.ObjectFormat: unknown", err.Error())
+	})
+	t.Run("data after JSON array", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(`[] {}`), FormatJSON)
+		_, err := dec.Next()
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "line 1: unexpected data after JSON array", err.Error())
+	})
+}
+
+func TestDecoder_Next_LargeInput(t *testing.T) {
+	const count = 10_000
+	for _, format := range []ObjectFormat{FormatYAML, FormatJSON} {
+		t.Run(format.String(), func(t *testing.T) {
+			pr, pw := io.Pipe()
+			go func() {
+				services := make([]openslo.Object, 0, count)
+				for i := range count {
+					metadata := v1.Metadata{Name: fmt.Sprintf("service-%d", i)}
+					services = append(services, v1.NewService(metadata, v1.ServiceSpec{}))
+				}
+				_ = pw.CloseWithError(Encode(pw, format, services...))
+			}()
+			objects := decodeAll(t, NewDecoder(pr, format))
+			assert.Require(t, assert.Len(t, objects, count))
+			assert.Equal(t, "service-9999", objects[count-1].GetName())
+		})
+	}
+}
+
+func decodeAll(t *testing.T, dec *Decoder) []openslo.Object {
+	t.Helper()
+	var objects []openslo.Object
+	for {
+		object, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			return objects
+		}
+		assert.Require(t, assert.NoError(t, err))
+		objects = append(objects, object)
+	}
+}
+
+func TestDecoder_Position(t *testing.T) {
+	t.Run("YAML", func(t *testing.T) {
+		data := readTestData(t, testData, "decode/mixed_documents.yaml")
+		dec := NewDecoder(bytes.NewReader(data), FormatYAML).WithFileName("mixed_documents.yaml")
+		expected := []struct{ document, line int }{
+			{0, 3}, {0, 11}, {1, 17}, {2, 22}, {2, 22}, {3, 24}, {3, 29},
+		}
+		for i, exp := range expected {
+			_, err := dec.Next()
+			assert.Require(t, assert.NoError(t, err))
+			assert.Equal(t, Position{
+				File:     "mixed_documents.yaml",
+				Document: exp.document,
+				Index:    i,
+				Offset:   lineOffset(data, exp.line),
+				Line:     exp.line,
+			}, dec.Position())
+		}
+	})
+	t.Run("JSON", func(t *testing.T) {
+		data := readTestData(t, testData, "decode/sequence_of_objects.json")
+		dec := NewDecoder(bytes.NewReader(data), FormatJSON)
+		for i, line := range []int{2, 13} {
+			_, err := dec.Next()
+			assert.Require(t, assert.NoError(t, err))
+			assert.Equal(t, Position{
+				Index:  i,
+				Offset: lineOffset(data, line) + 2,
+				Line:   line,
+			}, dec.Position())
+		}
+	})
+	t.Run("JSON stream", func(t *testing.T) {
+		data := "\n\n  {\"apiVersion\": \"openslo/v1\", \"kind\": \"Service\", \"metadata\": {\"name\": \"a\"}}\n" +
+			"{\"apiVersion\": \"openslo/v1\", \"kind\": \"Service\", \"metadata\": {\"name\": \"b\"}}"
+		dec := NewDecoder(strings.NewReader(data), FormatJSON)
+		_, err := dec.Next()
+		assert.Require(t, assert.NoError(t, err))
+		assert.Equal(t, Position{Offset: 4, Line: 3}, dec.Position())
+		_, err = dec.Next()
+		assert.Require(t, assert.NoError(t, err))
+		assert.Equal(t, Position{Document: 1, Index: 1, Offset: 79, Line: 4}, dec.Position())
+	})
+}
+
+func TestDecoder_Next_DecodeError(t *testing.T) {
+	data := `- apiVersion: openslo/v1
+  kind: Service
+  metadata:
+    name: valid
+- apiVersion: openslo/v1
+  kind: Service
+  metadata:
+    name: invalid
+  unknownField: true
+`
+	dec := NewDecoder(strings.NewReader(data), FormatYAML).WithFileName("services.yaml")
+	_, err := dec.Next()
+	assert.Require(t, assert.NoError(t, err))
+
+	_, err = dec.Next()
+
+	assert.Require(t, assert.Error(t, err))
+	var decodeErr *DecodeError
+	assert.Require(t, assert.True(t, errors.As(err, &decodeErr)))
+	assert.Equal(t, Position{
+		File:   "services.yaml",
+		Index:  1,
+		Offset: lineOffset([]byte(data), 5),
+		Line:   5,
+	}, decodeErr.Position)
+	assert.Equal(t, `services.yaml:5: failed to decode openslo/v1 Service: json: unknown field "unknownField"`,
+		err.Error())
+}
+
+func TestDecoder_Next_SkipUnsupported(t *testing.T) {
+	const data = `apiVersion: openslo/v1
+kind: Unknown
+---
+apiVersion: openslo/v3
+kind: Service
+---
+apiVersion: apps/v1
+kind: Deployment
+---
+apiVersion: openslo/v1
+kind: Service
+metadata:
+  name: my-service
+`
+	t.Run("non-OpenSLO documents are not skipped", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(data), FormatYAML).
+			WithOptions(DecodeOptions{SkipUnsupported: true})
+		_, err := dec.Next()
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "line 7: unsupported openslo.Version: apps/v1", err.Error())
+	})
+	t.Run("skip non-OpenSLO documents", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(data), FormatYAML).
+			WithOptions(DecodeOptions{SkipUnsupported: true}).
+			WithNonOpenSLOPolicy(NonOpenSLOPolicySkip)
+		object, err := dec.Next()
+		assert.Require(t, assert.NoError(t, err))
+		assert.Equal(t, "my-service", object.GetName())
+		_, err = dec.Next()
+		assert.True(t, errors.Is(err, io.EOF))
+	})
+}
+
+// lineOffset returns the byte offset of the one-based line.
+func lineOffset(data []byte, line int) int64 {
+	var offset int64
+	for range line - 1 {
+		i := bytes.IndexByte(data[offset:], '\n')
+		offset += int64(i) + 1
+	}
+	return offset
+}
+
+func TestDecodeWithOptions(t *testing.T) {
+	const data = `- apiVersion: openslo/v1
+  kind: Service
+  metadata:
+    name: first
+- apiVersion: openslo/v1
+  kind: Service
+  metadata:
+    name: second
+  unknownField: true
+- apiVersion: openslo/v1
+  kind: Unknown
+  metadata:
+    name: third
+- apiVersion: apps/v1
+  kind: Deployment
+- apiVersion: openslo/v1
+  kind: Service
+  metadata:
+    name: [fourth
+- apiVersion: openslo/v1
+  kind: Service
+  metadata:
+    name: fifth
+`
+	tests := map[string]struct {
+		options  DecodeOptions
+		expected []string
+		errors   []string
+	}{
+		"strict": {
+			errors: []string{`line 5: failed to decode openslo/v1 Service: json: unknown field "unknownField"`},
+		},
+		"allow unknown fields": {
+			options: DecodeOptions{AllowUnknownFields: true},
+			errors: []string{"line 10: failed to decode openslo/v1 Unknown:" +
+				" unsupported openslo.Kind: Unknown for version: openslo/v1"},
+		},
+		"continue on error": {
+			options:  DecodeOptions{ContinueOnError: true},
+			expected: []string{"first", "fifth"},
+			errors: []string{
+				`line 5: failed to decode openslo/v1 Service: json: unknown field "unknownField"`,
+				"line 10: failed to decode openslo/v1 Unknown:" +
+					" unsupported openslo.Kind: Unknown for version: openslo/v1",
+				"line 14: unsupported openslo.Version: apps/v1",
+				"line 16: error converting YAML to JSON: yaml: line 4: did not find expected ',' or ']'",
+			},
+		},
+		"all options": {
+			options: DecodeOptions{
+				AllowUnknownFields: true,
+				ContinueOnError:    true,
+				SkipUnsupported:    true,
+			},
+			expected: []string{"first", "second", "fifth"},
+			errors: []string{
+				"line 14: unsupported openslo.Version: apps/v1",
+				"line 16: error converting YAML to JSON: yaml: line 4: did not find expected ',' or ']'",
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			objects, err := DecodeWithOptions(strings.NewReader(data), FormatYAML, test.options)
+			names := make([]string, 0, len(objects))
+			for _, object := range objects {
+				names = append(names, object.GetName())
+			}
+			assert.Equal(t, len(test.expected), len(names))
+			for i := range test.expected {
+				assert.Equal(t, test.expected[i], names[i])
+			}
+			assert.Require(t, assert.Error(t, err))
+			if !test.options.ContinueOnError {
+				assert.Equal(t, test.errors[0], err.Error())
+				return
+			}
+			var decodeErrs DecodeErrors
+			assert.Require(t, assert.True(t, errors.As(err, &decodeErrs)))
+			assert.Require(t, assert.Len(t, decodeErrs, len(test.errors)))
+			for i := range test.errors {
+				assert.Equal(t, test.errors[i], decodeErrs[i].Error())
+			}
+			assert.Equal(t, strings.Join(test.errors, "\n"), err.Error())
+		})
+	}
+}
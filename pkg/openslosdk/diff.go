@@ -0,0 +1,253 @@
+package openslosdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/nobl9/govy/pkg/jsonpath"
+
+	"github.com/OpenSLO/go-sdk/internal"
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+)
+
+// DiffType describes how an [openslo.Object] has changed between two sets of objects.
+type DiffType string
+
+const (
+	// DiffTypeAdded is used when an object is present only in the new set.
+	DiffTypeAdded DiffType = "added"
+	// DiffTypeRemoved is used when an object is present only in the old set.
+	DiffTypeRemoved DiffType = "removed"
+	// DiffTypeModified is used when an object is present in both sets, but its contents differ.
+	DiffTypeModified DiffType = "modified"
+)
+
+// ObjectDiff describes a change of a single [openslo.Object], identified by its version, kind and name.
+type ObjectDiff struct {
+	Type    DiffType
+	Version openslo.Version
+	Kind    openslo.Kind
+	Name    string
+	// Old is the object from the old set, it is nil if the object was added.
+	Old openslo.Object
+	// New is the object from the new set, it is nil if the object was removed.
+	New openslo.Object
+	// Changes lists the modified fields, it is only set for [DiffTypeModified].
+	Changes []FieldChange
+}
+
+// String returns a human-readable summary of the change, followed by the field changes, one per line.
+// Example:
+//
+//	modified v1.SLO 'my-slo'
+//	  spec.objectives[0].target: 0.99 -> 0.995
+func (d ObjectDiff) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s", d.Type, internal.FormatObjectName(d.Version, d.Kind, d.Name))
+	for _, change := range d.Changes {
+		b.WriteString("\n  ")
+		b.WriteString(change.String())
+	}
+	return b.String()
+}
+
+// FieldChange describes a change of a single field of an [openslo.Object].
+// The values are represented the same way they would be decoded from JSON,
+// for instance, numbers are represented as float64 and objects as map[string]any.
+type FieldChange struct {
+	// Path is the property path of the field, relative to the root of the object, e.g. 'spec.objectives[0].target'.
+	// It follows the same format as the property paths reported by validation errors.
+	Path string
+	// Old is the previous value of the field, it is nil if the field was added.
+	Old any
+	// New is the current value of the field, it is nil if the field was removed.
+	New any
+}
+
+// String returns the change in the form of 'path: old -> new', e.g. 'spec.objectives[0].target: 0.99 -> 0.995'.
+// Values are encoded as JSON, absent values are represented as '<none>'.
+func (c FieldChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Path, formatDiffValue(c.Old), formatDiffValue(c.New))
+}
+
+// DiffOptions configures how [DiffWithOptions] compares objects.
+// The zero value represents the default behavior of [Diff].
+type DiffOptions struct {
+	// InlineReferences makes [ReferenceInliner] inline the references of both sets before they are compared.
+	// This way a change of a referenced object is also reported for every object which references it.
+	// Referenced objects are not removed from the sets.
+	InlineReferences bool
+	// Normalize makes [Normalize] convert both sets to their canonical forms before they are compared,
+	// so that equivalent forms, like [v1.SLOObjective] target expressed as percentage, are not reported as changes.
+	// It is applied after the references are inlined.
+	Normalize bool
+}
+
+// Diff compares two sets of [openslo.Object] and reports the objects which were added, removed or modified.
+// Objects are matched by their version, kind and name, and are compared field by field.
+// Removed and modified objects are reported in the order of the old set, followed by the added objects
+// in the order of the new set.
+// An error is returned if either set contains more than one object with the same version, kind and name.
+func Diff(oldObjects, newObjects []openslo.Object) ([]ObjectDiff, error) {
+	return DiffWithOptions(oldObjects, newObjects, DiffOptions{})
+}
+
+// DiffWithOptions works like [Diff], but allows customizing the comparison with [DiffOptions].
+func DiffWithOptions(oldObjects, newObjects []openslo.Object, options DiffOptions) ([]ObjectDiff, error) {
+	if options.InlineReferences {
+		var err error
+		if oldObjects, err = NewReferenceInliner(oldObjects...).Inline(); err != nil {
+			return nil, fmt.Errorf("failed to inline old objects: %w", err)
+		}
+		if newObjects, err = NewReferenceInliner(newObjects...).Inline(); err != nil {
+			return nil, fmt.Errorf("failed to inline new objects: %w", err)
+		}
+	}
+	if options.Normalize {
+		oldObjects = Normalize(oldObjects...)
+		newObjects = Normalize(newObjects...)
+	}
+	oldIndex, err := indexDiffObjects(oldObjects)
+	if err != nil {
+		return nil, fmt.Errorf("invalid old objects: %w", err)
+	}
+	newIndex, err := indexDiffObjects(newObjects)
+	if err != nil {
+		return nil, fmt.Errorf("invalid new objects: %w", err)
+	}
+	var diffs []ObjectDiff
+	for _, oldObject := range oldObjects {
+		key := newObjectKey(oldObject, oldObject.GetName())
+		newObject, ok := newIndex[key]
+		if !ok {
+			diffs = append(diffs, newObjectDiff(DiffTypeRemoved, key, oldObject, nil))
+			continue
+		}
+		changes, err := diffObjects(oldObject, newObject)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		diff := newObjectDiff(DiffTypeModified, key, oldObject, newObject)
+		diff.Changes = changes
+		diffs = append(diffs, diff)
+	}
+	for _, newObject := range newObjects {
+		key := newObjectKey(newObject, newObject.GetName())
+		if _, ok := oldIndex[key]; !ok {
+			diffs = append(diffs, newObjectDiff(DiffTypeAdded, key, nil, newObject))
+		}
+	}
+	return diffs, nil
+}
+
+func newObjectDiff(typ DiffType, key objectKey, oldObject, newObject openslo.Object) ObjectDiff {
+	return ObjectDiff{
+		Type:    typ,
+		Version: key.version,
+		Kind:    key.kind,
+		Name:    key.name,
+		Old:     oldObject,
+		New:     newObject,
+	}
+}
+
+func indexDiffObjects(objects []openslo.Object) (map[objectKey]openslo.Object, error) {
+	index := make(map[objectKey]openslo.Object, len(objects))
+	for _, object := range objects {
+		key := newObjectKey(object, object.GetName())
+		if _, ok := index[key]; ok {
+			return nil, fmt.Errorf("%s is defined more than once", object)
+		}
+		index[key] = object
+	}
+	return index, nil
+}
+
+// diffObjects compares the JSON representations of both objects.
+func diffObjects(oldObject, newObject openslo.Object) ([]FieldChange, error) {
+	oldValue, err := toDiffValue(oldObject)
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := toDiffValue(newObject)
+	if err != nil {
+		return nil, err
+	}
+	return diffValues(jsonpath.New(), oldValue, newValue, nil), nil
+}
+
+func toDiffValue(object openslo.Object) (any, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s to JSON: %w", object, err)
+	}
+	var value any
+	if err = json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("failed to decode %s from JSON: %w", object, err)
+	}
+	return value, nil
+}
+
+// diffValues appends the changes between the old and new values to the changes slice.
+// Maps are compared key by key and slices element by element,
+// any other values, or values of different types, are compared as a whole.
+func diffValues(path jsonpath.Path, oldValue, newValue any, changes []FieldChange) []FieldChange {
+	switch oldTyped := oldValue.(type) {
+	case map[string]any:
+		newTyped, ok := newValue.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(oldTyped)+len(newTyped))
+		for key := range oldTyped {
+			keys = append(keys, key)
+		}
+		for key := range newTyped {
+			if _, ok = oldTyped[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			changes = diffValues(path.Name(key), oldTyped[key], newTyped[key], changes)
+		}
+		return changes
+	case []any:
+		newTyped, ok := newValue.([]any)
+		if !ok {
+			break
+		}
+		for i := range max(len(oldTyped), len(newTyped)) {
+			var oldElement, newElement any
+			if i < len(oldTyped) {
+				oldElement = oldTyped[i]
+			}
+			if i < len(newTyped) {
+				newElement = newTyped[i]
+			}
+			changes = diffValues(path.Index(uint(i)), oldElement, newElement, changes)
+		}
+		return changes
+	}
+	if reflect.DeepEqual(oldValue, newValue) {
+		return changes
+	}
+	return append(changes, FieldChange{Path: path.String(), Old: oldValue, New: newValue})
+}
+
+func formatDiffValue(value any) string {
+	if value == nil {
+		return "<none>"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,117 @@
+package openslosdk
+
+import (
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+)
+
+func TestDiff(t *testing.T) {
+	oldObjects := decodeTestObjects(t, "diff/old.yaml")
+	newObjects := decodeTestObjects(t, "diff/new.yaml")
+
+	diffs, err := Diff(oldObjects, newObjects)
+	assert.Require(t, assert.NoError(t, err))
+
+	assert.Require(t, assert.Len(t, diffs, 4))
+	assert.Equal(t, ObjectDiff{
+		Type:    DiffTypeModified,
+		Version: openslo.VersionV1,
+		Kind:    openslo.KindSLO,
+		Name:    "web-latency",
+		Old:     oldObjects[1],
+		New:     newObjects[1],
+		Changes: []FieldChange{
+			{Path: "metadata.annotations['openslo.com/owner']", Old: "team-a", New: "team-b"},
+			{Path: "spec.description", Old: nil, New: "Latency of the web service."},
+			{Path: "spec.objectives[0].target", Old: 0.99, New: 0.995},
+			{
+				Path: "spec.objectives[1]",
+				Old:  nil,
+				New:  map[string]any{"target": 0.9, "op": "lte", "value": float64(5)},
+			},
+		},
+	}, diffs[0])
+	assert.Equal(t, DiffTypeModified, diffs[1].Type)
+	assert.Equal(t, DiffTypeRemoved, diffs[2].Type)
+	assert.Equal(t, oldObjects[3], diffs[2].Old)
+	assert.Equal(t, nil, diffs[2].New)
+	assert.Equal(t, DiffTypeAdded, diffs[3].Type)
+	assert.Equal(t, nil, diffs[3].Old)
+	assert.Equal(t, newObjects[3], diffs[3].New)
+
+	var summaries []string
+	for _, diff := range diffs {
+		summaries = append(summaries, diff.String())
+	}
+	assert.Equal(t, []string{
+		`modified v1.SLO 'web-latency'
+  metadata.annotations['openslo.com/owner']: "team-a" -> "team-b"
+  spec.description: <none> -> "Latency of the web service."
+  spec.objectives[0].target: 0.99 -> 0.995
+  spec.objectives[1]: <none> -> {"op":"lte","target":0.9,"value":5}`,
+		`modified v1.SLI 'web-latency-sli'
+  spec.thresholdMetric.metricSource.spec.query: "latency_seconds" -> "latency_seconds{code=\"200\"}"`,
+		`removed v1.AlertCondition 'fast-burn'`,
+		`added v1.AlertNotificationTarget 'on-call'`,
+	}, summaries)
+}
+
+func TestDiff_NoChanges(t *testing.T) {
+	objects := decodeTestObjects(t, "diff/old.yaml")
+
+	diffs, err := Diff(objects, decodeTestObjects(t, "diff/old.yaml"))
+	assert.Require(t, assert.NoError(t, err))
+	assert.Len(t, diffs, 0)
+}
+
+func TestDiffWithOptions_InlineReferences(t *testing.T) {
+	oldObjects := decodeTestObjects(t, "diff/old.yaml")
+	newObjects := decodeTestObjects(t, "diff/new.yaml")
+
+	diffs, err := DiffWithOptions(oldObjects, newObjects, DiffOptions{InlineReferences: true})
+	assert.Require(t, assert.NoError(t, err))
+
+	assert.Require(t, assert.Len(t, diffs, 4))
+	assert.Equal(t, DiffTypeModified, diffs[0].Type)
+	assert.Equal(t, openslo.KindSLO, diffs[0].Kind)
+	assert.Equal(t, FieldChange{
+		Path: "spec.indicator.spec.thresholdMetric.metricSource.spec.query",
+		Old:  "latency_seconds",
+		New:  `latency_seconds{code="200"}`,
+	}, diffs[0].Changes[2])
+	assert.Equal(t, openslo.KindSLI, diffs[1].Kind)
+}
+
+func TestDiff_Errors(t *testing.T) {
+	objects := decodeTestObjects(t, "validate_set/duplicates.yaml")
+
+	_, err := Diff(objects, nil)
+	assert.Require(t, assert.Error(t, err))
+	assert.Equal(t, "invalid old objects: v1.Service 'web' is defined more than once", err.Error())
+
+	_, err = Diff(nil, objects)
+	assert.Require(t, assert.Error(t, err))
+	assert.Equal(t, "invalid new objects: v1.Service 'web' is defined more than once", err.Error())
+
+	invalid := decodeTestObjects(t, "validate_set/invalid.yaml")
+	_, err = DiffWithOptions(nil, invalid, DiffOptions{InlineReferences: true})
+	assert.Require(t, assert.Error(t, err))
+	assert.Equal(t, "failed to inline new objects: failed to inline v1.AlertPolicy 'my-alert-policy': "+
+		"v1.AlertNotificationTarget 'devs-email-notification' referenced at 'spec.notificationTargets[0].targetRef' "+
+		"does not exist", err.Error())
+}
+
+func TestDiffWithOptions_Normalize(t *testing.T) {
+	oldObjects := decodeTestObjects(t, "diff/old.yaml")
+	newObjects := decodeTestObjects(t, "diff/normalized.yaml")
+
+	diffs, err := Diff(oldObjects, newObjects)
+	assert.Require(t, assert.NoError(t, err))
+	assert.Len(t, diffs, 2)
+
+	diffs, err = DiffWithOptions(oldObjects, newObjects, DiffOptions{Normalize: true})
+	assert.Require(t, assert.NoError(t, err))
+	assert.Len(t, diffs, 0)
+}
@@ -0,0 +1,471 @@
+package openslosdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	yamlv3 "go.yaml.in/yaml/v3"
+	"sigs.k8s.io/yaml"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+)
+
+// DecodeEditableYAML decodes YAML documents from [io.Reader] into [EditableYAML].
+// Unlike [Decode], it retains the original YAML node tree of every object,
+// which allows modifying the objects and encoding them back with [EditableYAML.Encode],
+// while preserving comments, key order and formatting of the unchanged parts.
+//
+// Documents which are not OpenSLO objects (e.g. Kubernetes resources) are retained as they are,
+// but are not exposed through [EditableYAML.Objects].
+func DecodeEditableYAML(r io.Reader) (*EditableYAML, error) {
+	dec := yamlv3.NewDecoder(r)
+	editable := &EditableYAML{}
+	for i := 0; ; i++ {
+		var document yamlv3.Node
+		if err := dec.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				return editable, nil
+			}
+			return nil, fmt.Errorf("failed to decode YAML document %d: %w", i, err)
+		}
+		editable.documents = append(editable.documents, newEditableDocument(&document))
+		if len(document.Content) == 0 {
+			continue
+		}
+		root := document.Content[0]
+		nodes := []*yamlv3.Node{root}
+		if root.Kind == yamlv3.SequenceNode {
+			nodes = root.Content
+		}
+		for _, node := range nodes {
+			object, err := decodeYAMLNode(node)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode object at line %d: %w", node.Line, err)
+			}
+			if object == nil {
+				continue
+			}
+			editable.objects = append(editable.objects, &EditableObject{object: object, node: node})
+		}
+	}
+}
+
+// EditableYAML holds [openslo.Object] decoded with [DecodeEditableYAML]
+// along with the YAML documents they were decoded from.
+type EditableYAML struct {
+	documents []editableDocument
+	objects   []*EditableObject
+}
+
+// editableDocument is a single YAML document along with the indentation style it was written in.
+type editableDocument struct {
+	node *yamlv3.Node
+	// indent is the number of spaces nested mappings are indented with.
+	indent int
+	// compactSequences is true if sequences are not indented relative to their parent key,
+	// i.e. '- ' is considered part of the indentation.
+	compactSequences bool
+}
+
+// newEditableDocument detects the indentation style of the document
+// from the first nested mapping and the first sequence nested in a mapping.
+// If the document contains no such nodes, two spaces and indented sequences are assumed.
+func newEditableDocument(node *yamlv3.Node) editableDocument {
+	document := editableDocument{node: node}
+	var detectIndent, detectSequences bool
+	var walk func(node *yamlv3.Node)
+	walk = func(node *yamlv3.Node) {
+		if node.Kind == yamlv3.MappingNode && node.Style&yamlv3.FlowStyle == 0 {
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				key, value := node.Content[i], node.Content[i+1]
+				if value.Style&yamlv3.FlowStyle != 0 || len(value.Content) == 0 || value.Line == key.Line {
+					continue
+				}
+				switch {
+				case value.Kind == yamlv3.MappingNode && !detectIndent:
+					document.indent = value.Column - key.Column
+					detectIndent = true
+				case value.Kind == yamlv3.SequenceNode && !detectSequences:
+					document.compactSequences = value.Column == key.Column
+					detectSequences = true
+				}
+			}
+		}
+		for _, child := range node.Content {
+			walk(child)
+		}
+	}
+	walk(node)
+	if document.indent < 2 {
+		document.indent = 2
+	}
+	return document
+}
+
+// Objects returns all the decoded [EditableObject] in the order they appear in the source.
+func (e *EditableYAML) Objects() []*EditableObject {
+	return e.objects
+}
+
+// Encode writes the YAML documents, including all the changes made with [EditableObject.Update], to [io.Writer].
+// Every document retains its original indentation, both of nested mappings and sequences.
+func (e *EditableYAML) Encode(out io.Writer) error {
+	for i, document := range e.documents {
+		if i > 0 {
+			if _, err := io.WriteString(out, "---\n"); err != nil {
+				return fmt.Errorf("failed to write YAML document separator: %w", err)
+			}
+		}
+		enc := yamlv3.NewEncoder(out)
+		enc.SetIndent(document.indent)
+		if document.compactSequences {
+			enc.CompactSeqIndent()
+		}
+		if err := enc.Encode(document.node); err != nil {
+			return fmt.Errorf("failed to encode YAML document: %w", err)
+		}
+		if err := enc.Close(); err != nil {
+			return fmt.Errorf("failed to encode YAML document: %w", err)
+		}
+	}
+	return nil
+}
+
+// EditableObject is a single [openslo.Object] which is part of [EditableYAML].
+type EditableObject struct {
+	object openslo.Object
+	node   *yamlv3.Node
+}
+
+// Object returns the current version of the [openslo.Object].
+func (e *EditableObject) Object() openslo.Object {
+	return e.object
+}
+
+// Update replaces the [openslo.Object] with the provided one and applies the differences to the YAML node tree.
+// Only the nodes whose values have changed are modified, all other nodes retain their comments,
+// ordering and style.
+// Keys of the fields which are not present in the encoded object are removed,
+// unless they hold zero values (e.g. 'false'), which are omitted during encoding.
+// Keys which do not correspond to any field of the object's type are retained.
+// List elements are matched by their 'name' or 'metadata.name', if every element has a unique one,
+// otherwise they are matched by their position.
+func (e *EditableObject) Update(object openslo.Object) error {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", object, err)
+	}
+	var document yamlv3.Node
+	if err = yamlv3.Unmarshal(data, &document); err != nil {
+		return fmt.Errorf("failed to convert %s to YAML node: %w", object, err)
+	}
+	updated := document.Content[0]
+	resetYAMLStyle(updated)
+	mergeYAMLNodes(e.node, updated, reflect.TypeOf(object))
+	e.object = object
+	return nil
+}
+
+// decodeYAMLNode decodes [openslo.Object] from the YAML node.
+// If the node is not an OpenSLO object, nil is returned.
+func decodeYAMLNode(node *yamlv3.Node) (openslo.Object, error) {
+	data, err := yamlv3.Marshal(node)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = yaml.YAMLToJSON(data); err != nil {
+		return nil, err
+	}
+	var generic genericObject
+	if err = json.Unmarshal(data, &generic); err != nil {
+		return nil, err
+	}
+	if generic.foreign {
+		return nil, nil
+	}
+	return decodeGenericObject(generic, true)
+}
+
+// mergeYAMLNodes applies the changes from src to dst, modifying dst in place.
+// The typ is the Go type src was encoded from, it is nil if the type is not known, e.g. for 'any' values.
+func mergeYAMLNodes(dst, src *yamlv3.Node, typ reflect.Type) {
+	switch {
+	case dst.Kind == yamlv3.MappingNode && src.Kind == yamlv3.MappingNode:
+		mergeYAMLMappings(dst, src, typ)
+	case dst.Kind == yamlv3.SequenceNode && src.Kind == yamlv3.SequenceNode:
+		mergeYAMLSequences(dst, src, yamlElementType(typ))
+	case dst.Kind == yamlv3.ScalarNode && src.Kind == yamlv3.ScalarNode:
+		if equalYAMLScalars(dst, src) {
+			return
+		}
+		if !keepYAMLScalarStyle(dst, src) {
+			dst.Style = 0
+		}
+		dst.Tag = src.Tag
+		dst.Value = src.Value
+	case dst.Kind == yamlv3.ScalarNode && src.Kind == yamlv3.SequenceNode &&
+		len(src.Content) == 1 && src.Content[0].Kind == yamlv3.ScalarNode &&
+		equalYAMLScalars(dst, src.Content[0]):
+		// Some fields, like labels, accept both a single value and a list of values.
+		return
+	default:
+		replaceYAMLNode(dst, src)
+	}
+}
+
+// mergeYAMLSequences merges the sequence nodes.
+// If every element of both sequences is named (see [getYAMLElementName]) and the names are unique,
+// the elements are matched by their names, so that removing or reordering elements
+// does not move the comments and style of one element onto another.
+// Otherwise, the elements are matched by their position.
+func mergeYAMLSequences(dst, src *yamlv3.Node, elemType reflect.Type) {
+	dstNamed, dstOK := getYAMLElementsByName(dst)
+	_, srcOK := getYAMLElementsByName(src)
+	if !dstOK || !srcOK {
+		for i, srcElement := range src.Content {
+			if i < len(dst.Content) {
+				mergeYAMLNodes(dst.Content[i], srcElement, elemType)
+			} else {
+				dst.Content = append(dst.Content, srcElement)
+			}
+		}
+		dst.Content = dst.Content[:len(src.Content)]
+		return
+	}
+	content := make([]*yamlv3.Node, 0, len(src.Content))
+	for _, srcElement := range src.Content {
+		dstElement, ok := dstNamed[getYAMLElementName(srcElement)]
+		if !ok {
+			content = append(content, srcElement)
+			continue
+		}
+		mergeYAMLNodes(dstElement, srcElement, elemType)
+		content = append(content, dstElement)
+	}
+	dst.Content = content
+}
+
+// getYAMLElementsByName maps the elements of the sequence node by their names.
+// If any element is not named or the names are not unique, false is returned.
+func getYAMLElementsByName(node *yamlv3.Node) (map[string]*yamlv3.Node, bool) {
+	elements := make(map[string]*yamlv3.Node, len(node.Content))
+	for _, element := range node.Content {
+		name := getYAMLElementName(element)
+		if name == "" {
+			return nil, false
+		}
+		if _, ok := elements[name]; ok {
+			return nil, false
+		}
+		elements[name] = element
+	}
+	return elements, true
+}
+
+// getYAMLElementName returns the value of the 'name' or 'metadata.name' key of the mapping node.
+// If the node has neither, an empty string is returned.
+func getYAMLElementName(node *yamlv3.Node) string {
+	if node.Kind != yamlv3.MappingNode {
+		return ""
+	}
+	if name := getYAMLMappingValue(node, "name"); name != nil && name.Kind == yamlv3.ScalarNode {
+		return name.Value
+	}
+	metadata := getYAMLMappingValue(node, "metadata")
+	if metadata == nil || metadata.Kind != yamlv3.MappingNode {
+		return ""
+	}
+	if name := getYAMLMappingValue(metadata, "name"); name != nil && name.Kind == yamlv3.ScalarNode {
+		return name.Value
+	}
+	return ""
+}
+
+// getYAMLMappingValue returns the value stored under the key of the mapping node, or nil if there is none.
+func getYAMLMappingValue(node *yamlv3.Node, key string) *yamlv3.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// mergeYAMLMappings merges the mapping nodes, the order of the existing keys is preserved,
+// while the new keys are appended in the order they appear in src.
+// Keys missing from src are removed only if they correspond to a field of typ and their value is not zero.
+func mergeYAMLMappings(dst, src *yamlv3.Node, typ reflect.Type) {
+	srcValues := make(map[string]*yamlv3.Node, len(src.Content)/2)
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		srcValues[src.Content[i].Value] = src.Content[i+1]
+	}
+	content := make([]*yamlv3.Node, 0, len(src.Content))
+	for i := 0; i+1 < len(dst.Content); i += 2 {
+		key, value := dst.Content[i], dst.Content[i+1]
+		fieldType, known := yamlFieldType(typ, key.Value)
+		srcValue, ok := srcValues[key.Value]
+		if !ok {
+			if !known || isZeroYAMLNode(value) {
+				content = append(content, key, value)
+			}
+			continue
+		}
+		mergeYAMLNodes(value, srcValue, fieldType)
+		content = append(content, key, value)
+		delete(srcValues, key.Value)
+	}
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		if _, ok := srcValues[src.Content[i].Value]; ok {
+			content = append(content, src.Content[i], src.Content[i+1])
+		}
+	}
+	dst.Content = content
+}
+
+// yamlFieldType returns the type of the value stored under the key of the mapping encoded from typ.
+// If typ is a struct, false is returned when none of its fields, including the embedded ones, is encoded as key.
+// Maps and unknown types accept any key.
+func yamlFieldType(typ reflect.Type, key string) (reflect.Type, bool) {
+	typ = indirectType(typ)
+	if typ == nil {
+		return nil, true
+	}
+	switch typ.Kind() {
+	case reflect.Map:
+		return typ.Elem(), true
+	case reflect.Struct:
+		for i := range typ.NumField() {
+			field := typ.Field(i)
+			tag := field.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, _, _ := strings.Cut(tag, ",")
+			if name == "" && field.Anonymous {
+				if fieldType, ok := yamlFieldType(field.Type, key); ok {
+					return fieldType, true
+				}
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			if field.IsExported() && name == key {
+				return field.Type, true
+			}
+		}
+		return nil, false
+	default:
+		return nil, false
+	}
+}
+
+// yamlElementType returns the type of the sequence elements encoded from typ.
+func yamlElementType(typ reflect.Type) reflect.Type {
+	typ = indirectType(typ)
+	if typ == nil || (typ.Kind() != reflect.Slice && typ.Kind() != reflect.Array) {
+		return nil
+	}
+	return typ.Elem()
+}
+
+// indirectType dereferences pointer types, nil is returned for interface types.
+func indirectType(typ reflect.Type) reflect.Type {
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ != nil && typ.Kind() == reflect.Interface {
+		return nil
+	}
+	return typ
+}
+
+// replaceYAMLNode replaces dst with src, retaining the comments of dst.
+func replaceYAMLNode(dst, src *yamlv3.Node) {
+	head, line, foot := dst.HeadComment, dst.LineComment, dst.FootComment
+	*dst = *src
+	dst.HeadComment, dst.LineComment, dst.FootComment = head, line, foot
+}
+
+func equalYAMLScalars(a, b *yamlv3.Node) bool {
+	if a.Value == b.Value {
+		// Timestamps are decoded as plain strings.
+		return a.ShortTag() == b.ShortTag() || a.ShortTag() == timestampTag || b.ShortTag() == timestampTag
+	}
+	var aValue, bValue any
+	if a.Decode(&aValue) != nil || b.Decode(&bValue) != nil {
+		return false
+	}
+	aFloat, aOk := toFloat64(aValue)
+	bFloat, bOk := toFloat64(bValue)
+	return aOk && bOk && aFloat == bFloat
+}
+
+const timestampTag = "!!timestamp"
+
+func toFloat64(v any) (float64, bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// keepYAMLScalarStyle returns true if the style of the existing scalar can be applied to the new value.
+func keepYAMLScalarStyle(dst, src *yamlv3.Node) bool {
+	if src.ShortTag() != "!!str" {
+		return false
+	}
+	switch dst.Style {
+	case yamlv3.DoubleQuotedStyle, yamlv3.SingleQuotedStyle:
+		return true
+	case yamlv3.LiteralStyle, yamlv3.FoldedStyle:
+		return strings.Contains(src.Value, "\n")
+	default:
+		return false
+	}
+}
+
+func isZeroYAMLNode(node *yamlv3.Node) bool {
+	switch node.Kind {
+	case yamlv3.MappingNode, yamlv3.SequenceNode:
+		return len(node.Content) == 0
+	case yamlv3.ScalarNode:
+		var value any
+		if err := node.Decode(&value); err != nil {
+			return false
+		}
+		switch value := value.(type) {
+		case nil:
+			return true
+		case bool:
+			return !value
+		case string:
+			return value == ""
+		default:
+			f, ok := toFloat64(value)
+			return ok && f == 0
+		}
+	default:
+		return false
+	}
+}
+
+// resetYAMLStyle clears the styles of nodes decoded from JSON, so that they are encoded in the block style.
+func resetYAMLStyle(node *yamlv3.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
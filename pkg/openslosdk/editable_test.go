@@ -0,0 +1,190 @@
+package openslosdk
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	yamlv3 "go.yaml.in/yaml/v3"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+)
+
+func TestDecodeEditableYAML(t *testing.T) {
+	input := readTestData(t, testData, "editable/input.yaml")
+
+	editable, err := DecodeEditableYAML(bytes.NewReader(input))
+	assert.Require(t, assert.NoError(t, err))
+
+	objects := editable.Objects()
+	assert.Require(t, assert.Len(t, objects, 2))
+	assert.Equal(t, "v1.SLO 'web-availability'", objects[0].Object().(v1.SLO).String())
+	assert.Equal(t, "v1.Service 'web'", objects[1].Object().(v1.Service).String())
+
+	t.Run("unchanged", func(t *testing.T) {
+		var buf bytes.Buffer
+		err = editable.Encode(&buf)
+		assert.Require(t, assert.NoError(t, err))
+		assert.Equal(t, string(input), buf.String())
+	})
+}
+
+func TestEditableYAML_Encode_RoundTrip(t *testing.T) {
+	for _, path := range []string{
+		"editable/input.yaml",
+		"encode/v1_slo.yaml",
+		"encode/multi_document.yaml",
+	} {
+		t.Run(path, func(t *testing.T) {
+			input := readTestData(t, testData, path)
+
+			editable, err := DecodeEditableYAML(bytes.NewReader(input))
+			assert.Require(t, assert.NoError(t, err))
+
+			var buf bytes.Buffer
+			err = editable.Encode(&buf)
+			assert.Require(t, assert.NoError(t, err))
+			assert.Equal(t, string(input), buf.String())
+		})
+	}
+}
+
+func TestEditableObject_Update(t *testing.T) {
+	editable, err := DecodeEditableYAML(bytes.NewReader(readTestData(t, testData, "editable/input.yaml")))
+	assert.Require(t, assert.NoError(t, err))
+	objects := editable.Objects()
+
+	slo := objects[0].Object().(v1.SLO)
+	slo.Metadata.Annotations = v1.Annotations{"owner": "web-team"}
+	slo.Spec.Objectives = slo.Spec.Objectives[:1]
+	slo.Spec.Objectives[0].Target = ptr(0.99)
+	err = objects[0].Update(slo)
+	assert.Require(t, assert.NoError(t, err))
+
+	service := objects[1].Object().(v1.Service)
+	service.Spec.Description = "Web application\nserving the main site"
+	err = objects[1].Update(service)
+	assert.Require(t, assert.NoError(t, err))
+
+	assert.Equal(t, slo, objects[0].Object())
+	var buf bytes.Buffer
+	err = editable.Encode(&buf)
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, string(readTestData(t, testData, "editable/updated.yaml")), buf.String())
+
+	// The encoded objects must decode to the updated objects.
+	dec := NewDecoder(&buf, FormatYAML).WithNonOpenSLOPolicy(NonOpenSLOPolicySkip)
+	for _, expected := range []openslo.Object{slo, service} {
+		decoded, err := dec.Next()
+		assert.Require(t, assert.NoError(t, err))
+		assert.Equal(t, expected, decoded)
+	}
+	_, err = dec.Next()
+	assert.True(t, errors.Is(err, io.EOF))
+}
+
+func TestEditableObject_Update_RemoveNamedElement(t *testing.T) {
+	const input = `apiVersion: openslo/v1
+kind: AlertPolicy
+metadata:
+  name: my-policy
+spec:
+  conditions:
+    - conditionRef: my-condition
+  notificationTargets:
+    # Developers are notified first.
+    - kind: AlertNotificationTarget
+      metadata:
+        name: devs
+      spec:
+        target: email # Team mailing list.
+    # Managers are notified only during working hours.
+    - kind: AlertNotificationTarget
+      metadata:
+        name: managers
+      spec:
+        target: email
+    # On-call engineers are paged.
+    - kind: AlertNotificationTarget
+      metadata:
+        name: on-call
+      spec:
+        target: pagerduty # Primary rotation.
+`
+	const expected = `apiVersion: openslo/v1
+kind: AlertPolicy
+metadata:
+  name: my-policy
+spec:
+  conditions:
+    - conditionRef: my-condition
+  notificationTargets:
+    # Developers are notified first.
+    - kind: AlertNotificationTarget
+      metadata:
+        name: devs
+      spec:
+        target: email # Team mailing list.
+    # On-call engineers are paged.
+    - kind: AlertNotificationTarget
+      metadata:
+        name: on-call
+      spec:
+        target: pagerduty # Primary rotation.
+`
+	editable, err := DecodeEditableYAML(strings.NewReader(input))
+	assert.Require(t, assert.NoError(t, err))
+	object := editable.Objects()[0]
+
+	policy := object.Object().(v1.AlertPolicy)
+	policy.Spec.NotificationTargets = slices.Delete(policy.Spec.NotificationTargets, 1, 2)
+	err = object.Update(policy)
+	assert.Require(t, assert.NoError(t, err))
+
+	var buf bytes.Buffer
+	err = editable.Encode(&buf)
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestDecodeEditableYAML_Errors(t *testing.T) {
+	_, err := DecodeEditableYAML(strings.NewReader("apiVersion: openslo/v1\nkind: Service\nfoo: bar\n"))
+	assert.Require(t, assert.Error(t, err))
+	assert.Equal(t, `failed to decode object at line 1: failed to decode openslo/v1 Service:`+
+		` json: unknown field "foo"`, err.Error())
+}
+
+func TestMergeYAMLNodes_RetainsUnknownKeys(t *testing.T) {
+	type spec struct {
+		Description string         `json:"description,omitempty"`
+		Enabled     bool           `json:"enabled,omitempty"`
+		Extra       map[string]any `json:"extra,omitempty"`
+	}
+	var dst, src yamlv3.Node
+	err := yamlv3.Unmarshal([]byte(`description: foo
+enabled: false
+unknown: value # Not modeled by the struct.
+extra:
+  removed: value
+`), &dst)
+	assert.Require(t, assert.NoError(t, err))
+	err = yamlv3.Unmarshal([]byte(`{"extra": {"added": "value"}}`), &src)
+	assert.Require(t, assert.NoError(t, err))
+	resetYAMLStyle(&src)
+
+	mergeYAMLNodes(dst.Content[0], src.Content[0], reflect.TypeFor[spec]())
+
+	data, err := yamlv3.Marshal(&dst)
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, `enabled: false
+unknown: value # Not modeled by the struct.
+extra:
+    added: value
+`, string(data))
+}
@@ -1,46 +1,97 @@
 package openslosdk
 
 import (
-	"bufio"
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"regexp"
+	"reflect"
 	"strings"
 
 	"sigs.k8s.io/yaml"
 
 	"github.com/OpenSLO/go-sdk/pkg/openslo"
-	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
-	"github.com/OpenSLO/go-sdk/pkg/openslo/v1alpha"
-	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
 )
 
 // Decode reads objects from [io.Reader] and decodes them,
 // according to the provided [ObjectFormat], into a slice of [openslo.Object].
+// It is a convenience wrapper over [Decoder], errors related to a specific object are returned as [*DecodeError].
 func Decode(r io.Reader, format ObjectFormat) ([]openslo.Object, error) {
+	return DecodeWithOptions(r, format, DecodeOptions{})
+}
+
+// DecodeWithOptions works like [Decode], but allows customizing the decoding with [DecodeOptions].
+// If [DecodeOptions.ContinueOnError] is set, all the successfully decoded objects are returned
+// along with [DecodeErrors], which lists every object that failed to decode.
+func DecodeWithOptions(r io.Reader, format ObjectFormat, options DecodeOptions) ([]openslo.Object, error) {
 	if err := format.Validate(); err != nil {
 		return nil, err
 	}
-	switch format {
-	case FormatYAML:
-		return decodeYAML(r)
-	case FormatJSON:
-		return decodeJSON(r)
-	default:
-		return nil, fmt.Errorf("unsupported %[1]T: %[1]s", format)
+	objects, _, errs, err := readAllObjects(NewDecoder(r, format).WithOptions(options))
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		return objects, errs
+	}
+	return objects, nil
+}
+
+// readAllObjects reads all the objects from [Decoder] along with their [Position].
+// If [DecodeOptions.ContinueOnError] is set, errors related to a specific object are collected into [DecodeErrors],
+// errors which stop the decoder are collected as well, and the objects decoded so far are still returned.
+func readAllObjects(dec *Decoder) ([]openslo.Object, []Position, DecodeErrors, error) {
+	var (
+		objects   []openslo.Object
+		positions []Position
+		errs      DecodeErrors
+	)
+	for {
+		object, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			return objects, positions, errs, nil
+		}
+		if err != nil {
+			var decodeErr *DecodeError
+			if !dec.options.ContinueOnError || !errors.As(err, &decodeErr) {
+				return nil, nil, nil, err
+			}
+			errs = append(errs, decodeErr)
+			if dec.err != nil {
+				return objects, positions, errs, nil
+			}
+			continue
+		}
+		objects = append(objects, object)
+		positions = append(positions, dec.Position())
 	}
 }
 
 // Encode writes the provided [openslo.Object] to [io.Writer],
 // according to the provided [ObjectFormat].
 func Encode(out io.Writer, format ObjectFormat, objects ...openslo.Object) error {
+	return EncodeWithOptions(out, format, EncodeOptions{}, objects...)
+}
+
+// EncodeOptions configures how [EncodeWithOptions] encodes objects.
+// The zero value represents the default behavior of [Encode].
+type EncodeOptions struct {
+	// MultiDocument makes [FormatYAML] encode every object as a separate YAML document,
+	// with documents separated by '---', instead of encoding all objects as a single YAML sequence.
+	MultiDocument bool
+}
+
+// EncodeWithOptions works like [Encode], but allows customizing the encoding with [EncodeOptions].
+func EncodeWithOptions(out io.Writer, format ObjectFormat, options EncodeOptions, objects ...openslo.Object) error {
 	if err := format.Validate(); err != nil {
 		return err
 	}
 	switch format {
 	case FormatYAML:
+		if options.MultiDocument {
+			return encodeYAMLDocuments(out, objects)
+		}
 		data, err := yaml.Marshal(objects)
 		if err != nil {
 			return fmt.Errorf("failed to encode objects to YAML: %w", err)
@@ -56,245 +107,118 @@ func Encode(out io.Writer, format ObjectFormat, objects ...openslo.Object) error
 			return fmt.Errorf("failed to encode objects to JSON: %w", err)
 		}
 		return nil
+	case FormatJSONLines:
+		enc := json.NewEncoder(out)
+		for _, object := range objects {
+			if err := enc.Encode(object); err != nil {
+				return fmt.Errorf("failed to encode %s to JSON Lines: %w", object, err)
+			}
+		}
+		return nil
 	default:
 		return fmt.Errorf("unsupported %[1]T: %[1]s", format)
 	}
 }
 
+func encodeYAMLDocuments(out io.Writer, objects []openslo.Object) error {
+	for i, object := range objects {
+		data, err := yaml.Marshal(object)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s to YAML: %w", object, err)
+		}
+		if i > 0 {
+			data = append([]byte("---\n"), data...)
+		}
+		if _, err = out.Write(data); err != nil {
+			return fmt.Errorf("failed to write YAML data: %w", err)
+		}
+	}
+	return nil
+}
+
 type genericObject struct {
 	apiVersion openslo.Version
 	kind       openslo.Kind
 	data       json.RawMessage
+	// foreign is true if the API version does not belong to the OpenSLO specification
+	// and was not registered with [openslo.RegisterObject].
+	foreign bool
 }
 
 func (o *genericObject) UnmarshalJSON(data []byte) error {
-	var tmp struct {
-		APIVersion openslo.Version `json:"apiVersion"`
-		Kind       openslo.Kind    `json:"kind"`
+	var header struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
 	}
-	if err := json.Unmarshal(data, &tmp); err != nil {
+	if err := json.Unmarshal(data, &header); err != nil {
 		return fmt.Errorf("failed to decode object: %w", err)
 	}
-	o.apiVersion = tmp.APIVersion
-	o.kind = tmp.Kind
-	o.data = data
+	o.apiVersion = openslo.Version(header.APIVersion)
+	o.kind = openslo.Kind(header.Kind)
+	o.foreign = !isOpenSLOAPIVersion(header.APIVersion) && o.apiVersion.Validate() != nil
+	// Decoders may reuse the underlying buffer, the data has to be copied.
+	o.data = bytes.Clone(data)
 	return nil
 }
 
-func decodeYAML(r io.Reader) ([]openslo.Object, error) {
-	data, err := io.ReadAll(r)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read data: %w", err)
-	}
-	scanner := bufio.NewScanner(bytes.NewBuffer(data))
-	// Documents can have any size, at most it will be the whole data.
-	// This means sometimes we might exceed the limit imposed by bufio.Scanner.
-	maxTokenSize := len(data) + 1
-	scanner.Buffer(make([]byte, 0, len(data)), maxTokenSize)
-	scanner.Split(splitYAMLDocument)
-	var genericObjects []genericObject
-	for scanner.Scan() {
-		doc := scanner.Bytes()
-		if len(bytes.TrimSpace(doc)) == 0 {
-			continue
-		}
-		switch getYamlIdent(doc) {
-		case identArray:
-			var a []genericObject
-			if err = yaml.Unmarshal(doc, &a); err != nil {
-				return nil, err
-			}
-			genericObjects = append(genericObjects, a...)
-		case identObject:
-			var object genericObject
-			if err = yaml.Unmarshal(doc, &object); err != nil {
-				return nil, err
-			}
-			genericObjects = append(genericObjects, object)
-		}
-	}
-	if err = scanner.Err(); err != nil {
-		return nil, err
-	}
-	return decodeGenericObjects(genericObjects)
-}
-
-func decodeJSON(r io.Reader) ([]openslo.Object, error) {
-	data, err := io.ReadAll(r)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read data: %w", err)
-	}
-	var genericObjects []genericObject
-	switch getJsonIdent(data) {
-	case identArray:
-		if err = json.Unmarshal(data, &genericObjects); err != nil {
-			return nil, err
-		}
-	case identObject:
-		var object genericObject
-		if err = json.Unmarshal(data, &object); err != nil {
-			return nil, err
-		}
-		genericObjects = append(genericObjects, object)
-	}
-	return decodeGenericObjects(genericObjects)
+// isOpenSLOAPIVersion returns true if the API version belongs to the OpenSLO specification,
+// regardless of whether the specific version is supported or not.
+func isOpenSLOAPIVersion(apiVersion string) bool {
+	return strings.HasPrefix(apiVersion, "openslo/") || strings.HasPrefix(apiVersion, "openslo.com/")
 }
 
-func decodeGenericObjects(genericObjects []genericObject) ([]openslo.Object, error) {
-	objects := make([]openslo.Object, 0, len(genericObjects))
-	var decodeFunc func(genericObject) (openslo.Object, error)
-	for _, obj := range genericObjects {
-		switch obj.apiVersion {
-		case openslo.VersionV1alpha:
-			decodeFunc = decodeV1alphaObject
-		case openslo.VersionV1:
-			decodeFunc = decodeV1Object
-		case openslo.VersionV2alpha:
-			decodeFunc = decodeV2alphaObject
-		default:
-			return nil, fmt.Errorf("unsupported %[1]T: %[1]s", obj.apiVersion)
-		}
-		object, err := decodeFunc(obj)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decode %s %s: %w", obj.apiVersion, obj.kind, err)
-		}
-		objects = append(objects, object)
-	}
-	return objects, nil
+// unsupportedObjectError is returned when the object's version or kind is not supported.
+type unsupportedObjectError struct {
+	msg string
 }
 
-func decodeV1alphaObject(generic genericObject) (openslo.Object, error) {
-	switch generic.kind {
-	case openslo.KindService:
-		return decodeJSONObject[v1alpha.Service](generic.data)
-	case openslo.KindSLO:
-		return decodeJSONObject[v1alpha.SLO](generic.data)
-	default:
-		return nil, fmt.Errorf("unsupported %[1]T: %[1]s for version: %[2]s", generic.kind, generic.apiVersion)
-	}
+func (e *unsupportedObjectError) Error() string {
+	return e.msg
 }
 
-func decodeV1Object(generic genericObject) (openslo.Object, error) {
-	switch generic.kind {
-	case openslo.KindService:
-		return decodeJSONObject[v1.Service](generic.data)
-	case openslo.KindSLO:
-		return decodeJSONObject[v1.SLO](generic.data)
-	case openslo.KindSLI:
-		return decodeJSONObject[v1.SLI](generic.data)
-	case openslo.KindDataSource:
-		return decodeJSONObject[v1.DataSource](generic.data)
-	case openslo.KindAlertPolicy:
-		return decodeJSONObject[v1.AlertPolicy](generic.data)
-	case openslo.KindAlertCondition:
-		return decodeJSONObject[v1.AlertCondition](generic.data)
-	case openslo.KindAlertNotificationTarget:
-		return decodeJSONObject[v1.AlertNotificationTarget](generic.data)
-	default:
-		return nil, fmt.Errorf("unsupported %[1]T: %[1]s for version: %[2]s", generic.kind, generic.apiVersion)
+func newUnsupportedKindError(generic genericObject) error {
+	return &unsupportedObjectError{
+		msg: fmt.Sprintf("unsupported %[1]T: %[1]s for version: %[2]s", generic.kind, generic.apiVersion),
 	}
 }
 
-func decodeV2alphaObject(generic genericObject) (openslo.Object, error) {
-	switch generic.kind {
-	case openslo.KindService:
-		return decodeJSONObject[v2alpha.Service](generic.data)
-	case openslo.KindSLO:
-		return decodeJSONObject[v2alpha.SLO](generic.data)
-	case openslo.KindSLI:
-		return decodeJSONObject[v2alpha.SLI](generic.data)
-	case openslo.KindDataSource:
-		return decodeJSONObject[v2alpha.DataSource](generic.data)
-	case openslo.KindAlertPolicy:
-		return decodeJSONObject[v2alpha.AlertPolicy](generic.data)
-	case openslo.KindAlertCondition:
-		return decodeJSONObject[v2alpha.AlertCondition](generic.data)
-	case openslo.KindAlertNotificationTarget:
-		return decodeJSONObject[v2alpha.AlertNotificationTarget](generic.data)
-	default:
-		return nil, fmt.Errorf("unsupported %[1]T: %[1]s for version: %[2]s", generic.kind, generic.apiVersion)
+// decodeGenericObject decodes [genericObject] into a concrete [openslo.Object]
+// created with the [openslo.ObjectConstructor] registered for its version and kind.
+// If strict is true, unknown fields result in an error.
+func decodeGenericObject(generic genericObject, strict bool) (openslo.Object, error) {
+	constructor, ok := openslo.GetObjectConstructor(generic.apiVersion, generic.kind)
+	if !ok {
+		if len(openslo.GetRegisteredKinds(generic.apiVersion)) == 0 {
+			return nil, &unsupportedObjectError{msg: fmt.Sprintf("unsupported %[1]T: %[1]s", generic.apiVersion)}
+		}
+		return nil, fmt.Errorf("failed to decode %s %s: %w",
+			generic.apiVersion, generic.kind, newUnsupportedKindError(generic))
 	}
-}
-
-func decodeJSONObject[T openslo.Object](data json.RawMessage) (T, error) {
-	var object T
-	dec := json.NewDecoder(bytes.NewReader(data))
-	dec.DisallowUnknownFields()
-	if err := dec.Decode(&object); err != nil {
-		return object, err
+	object, err := decodeJSONObject(constructor(), generic.data, strict)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s %s: %w", generic.apiVersion, generic.kind, err)
 	}
 	return object, nil
 }
 
-type ident uint8
-
-const (
-	identArray = iota + 1
-	identObject
-)
-
-var jsonArrayIdentRegex = regexp.MustCompile(`^\s*\[`)
-
-func getJsonIdent(data []byte) ident {
-	if jsonArrayIdentRegex.Match(data) {
-		return identArray
-	}
-	return identObject
-}
-
-// For a valid array, the first non-whitespace, non-comment character must be a dash or a bracket.
-func getYamlIdent(data []byte) ident {
-	scanner := bufio.NewScanner(bytes.NewBuffer(data))
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if len(line) == 0 {
-			continue
-		}
-		switch line[0] {
-		case '#':
-			continue
-		case '[':
-			return identArray
-		case '-':
-			if line == "---" {
-				continue
-			}
-			return identArray
-		}
-		break
-	}
-	return identObject
-}
-
-// yamlDocSep includes a prefixed newline character as we do now want to split on the first
-// document separator located at the beginning of the file.
-const yamlDocSep = "\n---"
-
-// splitYAMLDocument is a bufio.SplitFunc for splitting YAML streams into individual documents.
-func splitYAMLDocument(data []byte, atEOF bool) (advance int, token []byte, err error) {
-	if atEOF && len(data) == 0 {
-		return 0, nil, nil
+// decodeJSONObject decodes JSON data into the provided [openslo.Object].
+// If the object is not a pointer, a pointer to its copy is used as the decoding target.
+func decodeJSONObject(object openslo.Object, data json.RawMessage, strict bool) (openslo.Object, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if strict {
+		dec.DisallowUnknownFields()
 	}
-	// We have a potential document terminator.
-	if i := bytes.Index(data, []byte(yamlDocSep)); i >= 0 {
-		sep := len(yamlDocSep)
-		i += sep
-		after := data[i:]
-		if len(after) == 0 {
-			if atEOF {
-				return len(data), data[:len(data)-sep], nil
-			}
-			return 0, nil, nil
-		}
-		if j := bytes.IndexByte(after, '\n'); j >= 0 {
-			return i + j + 1, data[0 : i-sep], nil
+	value := reflect.ValueOf(object)
+	if value.Kind() == reflect.Pointer {
+		if err := dec.Decode(object); err != nil {
+			return nil, err
 		}
-		return 0, nil, nil
+		return object, nil
 	}
-	// If we're at EOF, we have a final, non-terminated line. Return it.
-	if atEOF {
-		return len(data), data, nil
+	target := reflect.New(value.Type())
+	target.Elem().Set(value)
+	if err := dec.Decode(target.Interface()); err != nil {
+		return nil, err
 	}
-	// Request more data.
-	return 0, nil, nil
+	return target.Elem().Interface().(openslo.Object), nil
 }
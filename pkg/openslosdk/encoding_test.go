@@ -359,9 +359,14 @@ func TestEncode(t *testing.T) {
 			},
 		},
 	}
+	v1Service := v1.NewService(
+		v1.Metadata{Name: "foo", Labels: v1.Labels{"team": {"bar"}}},
+		v1.ServiceSpec{Description: "Foo service"},
+	)
 	tests := map[string]struct {
 		testDataFile string
 		objects      []openslo.Object
+		options      EncodeOptions
 	}{
 		"single YAML object": {
 			testDataFile: "encode/v1_slo.yaml",
@@ -371,14 +376,27 @@ func TestEncode(t *testing.T) {
 			testDataFile: "encode/v1_slo.json",
 			objects:      []openslo.Object{v1SLO},
 		},
+		"multiple YAML documents": {
+			testDataFile: "encode/multi_document.yaml",
+			objects:      []openslo.Object{v1SLO, v1Service},
+			options:      EncodeOptions{MultiDocument: true},
+		},
+		"JSON Lines": {
+			testDataFile: "encode/objects.jsonl",
+			objects:      []openslo.Object{v1SLO, v1Service},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			data := readTestData(t, testData, tc.testDataFile)
 			var buf bytes.Buffer
-			err := Encode(&buf, getFileFormat(tc.testDataFile), tc.objects...)
+			err := EncodeWithOptions(&buf, getFileFormat(tc.testDataFile), tc.options, tc.objects...)
 			assert.Require(t, assert.NoError(t, err))
 			assert.Equal(t, string(data), buf.String())
+
+			decoded, err := Decode(&buf, getFileFormat(tc.testDataFile))
+			assert.Require(t, assert.NoError(t, err))
+			assert.Equal(t, tc.objects, decoded)
 		})
 	}
 }
@@ -427,11 +445,14 @@ func findObjectsExamples(t *testing.T, root string) []openslo.Object {
 }
 
 func getFileFormat(path string) ObjectFormat {
-	format := FormatJSON
-	if filepath.Ext(path) == ".yaml" {
-		format = FormatYAML
+	switch filepath.Ext(path) {
+	case ".yaml":
+		return FormatYAML
+	case ".jsonl":
+		return FormatJSONLines
+	default:
+		return FormatJSON
 	}
-	return format
 }
 
 func readTestData(t *testing.T, fileSystem embed.FS, path string) []byte {
@@ -442,5 +463,3 @@ func readTestData(t *testing.T, fileSystem embed.FS, path string) []byte {
 	}
 	return data
 }
-
-func ptr[T any](v T) *T { return &v }
@@ -8,6 +8,8 @@ type ObjectFormat int
 const (
 	FormatYAML ObjectFormat = iota + 1
 	FormatJSON
+	// FormatJSONLines is the JSON Lines format, where every line holds a single JSON object.
+	FormatJSONLines
 )
 
 // String implements the [fmt.Stringer] interface.
@@ -17,6 +19,8 @@ func (f ObjectFormat) String() string {
 		return "yaml"
 	case FormatJSON:
 		return "json"
+	case FormatJSONLines:
+		return "jsonl"
 	default:
 		return "unknown"
 	}
@@ -25,7 +29,7 @@ func (f ObjectFormat) String() string {
 // Validate checks if [ObjectFormat] is supported.
 func (f ObjectFormat) Validate() error {
 	switch f {
-	case FormatYAML, FormatJSON:
+	case FormatYAML, FormatJSON, FormatJSONLines:
 		return nil
 	default:
 		return fmt.Errorf("unsupported %[1]T: %[1]s", f)
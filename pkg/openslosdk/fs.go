@@ -0,0 +1,176 @@
+package openslosdk
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+)
+
+// DecodeFS recursively decodes all YAML ('.yaml', '.yml'), JSON ('.json') and JSON Lines ('.jsonl') files
+// from [fs.FS] which match at least one of the provided patterns.
+// If no patterns are provided, all the files are decoded.
+// Along with the objects, their [Position] is returned, which records the source file path.
+//
+// It is a shorthand for [NewFSDecoder] with the default options,
+// use it directly if you need to customize the decoding, for instance with [FSDecoder.WithNonOpenSLOPolicy].
+func DecodeFS(fsys fs.FS, patterns ...string) ([]openslo.Object, []Position, error) {
+	return NewFSDecoder(fsys).Decode(patterns...)
+}
+
+// DecodeDir works like [DecodeFS] for the provided directory.
+// The recorded file paths are relative to the directory.
+func DecodeDir(dir string, patterns ...string) ([]openslo.Object, []Position, error) {
+	return DecodeFS(os.DirFS(dir), patterns...)
+}
+
+// NewFSDecoder creates a new [FSDecoder] for the provided [fs.FS].
+func NewFSDecoder(fsys fs.FS) *FSDecoder {
+	return &FSDecoder{fsys: fsys}
+}
+
+// FSDecoder decodes [openslo.Object] from files in [fs.FS].
+type FSDecoder struct {
+	fsys             fs.FS
+	nonOpenSLOPolicy NonOpenSLOPolicy
+	options          DecodeOptions
+}
+
+// WithNonOpenSLOPolicy sets the [NonOpenSLOPolicy] used for every decoded file.
+// It is useful when the OpenSLO definitions share directories with other manifests, like Kubernetes resources.
+func (f *FSDecoder) WithNonOpenSLOPolicy(policy NonOpenSLOPolicy) *FSDecoder {
+	f.nonOpenSLOPolicy = policy
+	return f
+}
+
+// WithOptions sets the [DecodeOptions] used for every decoded file.
+// If [DecodeOptions.ContinueOnError] is set, errors from all the files are collected into [DecodeErrors]
+// and returned along with the successfully decoded objects.
+func (f *FSDecoder) WithOptions(options DecodeOptions) *FSDecoder {
+	f.options = options
+	return f
+}
+
+// Decode recursively decodes all YAML ('.yaml', '.yml'), JSON ('.json') and JSON Lines ('.jsonl') files
+// which match at least one of the provided patterns.
+// If no patterns are provided, all the files are decoded.
+//
+// Patterns follow the [path.Match] syntax and are matched against slash-separated paths relative to the root,
+// in addition a '**' path segment matches any number of directories, e.g. 'slos/**/*.yaml'.
+// If a pattern matches a directory, all the files within it are decoded.
+// Files are decoded in lexical order.
+func (f *FSDecoder) Decode(patterns ...string) ([]openslo.Object, []Position, error) {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, nil, fmt.Errorf("invalid pattern '%s': %w", pattern, err)
+		}
+	}
+	var (
+		objects   []openslo.Object
+		positions []Position
+		errs      DecodeErrors
+	)
+	err := fs.WalkDir(f.fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		format, ok := getFormatFromExtension(name)
+		if !ok || !matchesAnyPattern(patterns, name) {
+			return nil
+		}
+		fileObjects, filePositions, fileErrs, err := f.decodeFile(name, format)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, fileObjects...)
+		positions = append(positions, filePositions...)
+		errs = append(errs, fileErrs...)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(errs) > 0 {
+		return objects, positions, errs
+	}
+	return objects, positions, nil
+}
+
+func (f *FSDecoder) decodeFile(
+	name string,
+	format ObjectFormat,
+) ([]openslo.Object, []Position, DecodeErrors, error) {
+	file, err := f.fsys.Open(name)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	return readAllObjects(NewDecoder(file, format).
+		WithFileName(name).
+		WithNonOpenSLOPolicy(f.nonOpenSLOPolicy).
+		WithOptions(f.options))
+}
+
+func getFormatFromExtension(name string) (ObjectFormat, bool) {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".yaml", ".yml":
+		return FormatYAML, true
+	case ".json":
+		return FormatJSON, true
+	case ".jsonl":
+		return FormatJSONLines, true
+	default:
+		return 0, false
+	}
+}
+
+// matchesAnyPattern returns true if the name or any of its parent directories matches at least one pattern.
+// If there are no patterns, it always returns true.
+func matchesAnyPattern(patterns []string, name string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	segments := strings.Split(name, "/")
+	for _, pattern := range patterns {
+		pattern = path.Clean(pattern)
+		if pattern == "." {
+			return true
+		}
+		patternSegments := strings.Split(pattern, "/")
+		for i := len(segments); i > 0; i-- {
+			if matchSegments(patternSegments, segments[:i]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchSegments matches path segments against pattern segments, '**' matches zero or more segments.
+func matchSegments(pattern, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(segments); i++ {
+				if matchSegments(pattern[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], segments[0]); !ok {
+			return false
+		}
+		pattern, segments = pattern[1:], segments[1:]
+	}
+	return len(segments) == 0
+}
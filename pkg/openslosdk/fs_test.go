@@ -0,0 +1,141 @@
+package openslosdk
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+)
+
+func TestDecodeFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"services.yaml": {Data: []byte(`- apiVersion: openslo/v1
+  kind: Service
+  metadata:
+    name: service-1
+- apiVersion: openslo/v1
+  kind: Service
+  metadata:
+    name: service-2
+`)},
+		"team-a/slos/service.yml": {Data: []byte(`apiVersion: openslo/v1
+kind: Service
+metadata:
+  name: service-3
+`)},
+		"team-a/slos/nested/service.json": {
+			Data: []byte(`{"apiVersion": "openslo/v1", "kind": "Service", "metadata": {"name": "service-4"}}`),
+		},
+		"team-b/service.YAML": {Data: []byte(`apiVersion: openslo.com/v2alpha
+kind: Service
+metadata:
+  name: service-5
+`)},
+		"team-b/deployment.yaml": {Data: []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+---
+apiVersion: openslo/v1
+kind: Service
+metadata:
+  name: service-6
+`)},
+		"README.md": {Data: []byte("# Not an OpenSLO file")},
+	}
+
+	tests := map[string]struct {
+		patterns []string
+		names    []string
+		files    []string
+	}{
+		"all files": {
+			names: []string{"service-1", "service-2", "service-4", "service-3", "service-6", "service-5"},
+			files: []string{
+				"services.yaml",
+				"services.yaml",
+				"team-a/slos/nested/service.json",
+				"team-a/slos/service.yml",
+				"team-b/deployment.yaml",
+				"team-b/service.YAML",
+			},
+		},
+		"directory": {
+			patterns: []string{"team-a"},
+			names:    []string{"service-4", "service-3"},
+			files:    []string{"team-a/slos/nested/service.json", "team-a/slos/service.yml"},
+		},
+		"glob": {
+			patterns: []string{"*.yaml", "team-b/*.YAML"},
+			names:    []string{"service-1", "service-2", "service-5"},
+			files:    []string{"services.yaml", "services.yaml", "team-b/service.YAML"},
+		},
+		"double star": {
+			patterns: []string{"**/*.json"},
+			names:    []string{"service-4"},
+			files:    []string{"team-a/slos/nested/service.json"},
+		},
+		"double star in the middle": {
+			patterns: []string{"team-a/**/service.*"},
+			names:    []string{"service-4", "service-3"},
+			files:    []string{"team-a/slos/nested/service.json", "team-a/slos/service.yml"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			objects, positions, err := NewFSDecoder(fsys).
+				WithNonOpenSLOPolicy(NonOpenSLOPolicySkip).
+				Decode(tc.patterns...)
+			assert.Require(t, assert.NoError(t, err))
+			assert.Require(t, assert.Len(t, positions, len(objects)))
+			var names, files []string
+			for i := range objects {
+				names = append(names, objects[i].GetName())
+				files = append(files, positions[i].File)
+			}
+			assert.Equal(t, tc.names, names)
+			assert.Equal(t, tc.files, files)
+		})
+	}
+}
+
+func TestDecodeFS_Errors(t *testing.T) {
+	t.Run("non-OpenSLO document", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"deployment.yaml": {Data: []byte("apiVersion: apps/v1\nkind: Deployment\n")},
+		}
+		_, _, err := DecodeFS(fsys)
+		assert.Require(t, assert.Error(t, err))
+		var decodeErr *DecodeError
+		assert.Require(t, assert.True(t, errors.As(err, &decodeErr)))
+		assert.Equal(t, "deployment.yaml:1: unsupported openslo.Version: apps/v1", err.Error())
+	})
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, _, err := DecodeFS(fstest.MapFS{}, "[")
+		assert.Require(t, assert.Error(t, err))
+		assert.Equal(t, "invalid pattern '[': syntax error in pattern", err.Error())
+	})
+}
+
+func TestFSDecoder_WithOptions(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.yaml": {Data: []byte("apiVersion: openslo/v1\nkind: Unknown\n")},
+		"b.yaml": {Data: []byte("apiVersion: openslo/v1\nkind: Service\nmetadata:\n  name: b\n")},
+		"c.json": {Data: []byte(`{"apiVersion": "openslo/v1", "kind": "Service", "metadata": {"name": "c"}, "x": 1}`)},
+	}
+
+	objects, positions, err := NewFSDecoder(fsys).
+		WithOptions(DecodeOptions{ContinueOnError: true}).
+		Decode()
+
+	assert.Require(t, assert.Len(t, objects, 1))
+	assert.Equal(t, "b", objects[0].GetName())
+	assert.Equal(t, "b.yaml:1", positions[0].String())
+	assert.Require(t, assert.Error(t, err))
+	var decodeErrs DecodeErrors
+	assert.Require(t, assert.True(t, errors.As(err, &decodeErrs)))
+	assert.Require(t, assert.Len(t, decodeErrs, 2))
+	assert.Equal(t, "a.yaml", decodeErrs[0].Position.File)
+	assert.Equal(t, "c.json", decodeErrs[1].Position.File)
+}
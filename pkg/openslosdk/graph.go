@@ -0,0 +1,343 @@
+package openslosdk
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/internal"
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+)
+
+// GraphEdgeType describes how an [openslo.Object] is linked with another [openslo.Object].
+type GraphEdgeType string
+
+const (
+	// GraphEdgeTypeReference is used when an object references another object by its name, e.g. 'indicatorRef'.
+	GraphEdgeTypeReference GraphEdgeType = "reference"
+	// GraphEdgeTypeInline is used when an object defines another object inline, e.g. 'indicator'.
+	GraphEdgeTypeInline GraphEdgeType = "inline"
+)
+
+// GraphNode represents a single [openslo.Object] in a [Graph].
+type GraphNode struct {
+	// ID uniquely identifies the node within the [Graph].
+	// Standalone objects are identified by their version, kind and name, e.g. 'openslo/v1/SLO/my-slo'.
+	// Inlined objects are identified by the ID of the top-level object and their property path,
+	// e.g. 'openslo/v1/SLO/my-slo#spec.indicator'.
+	ID      string
+	Version openslo.Version
+	Kind    openslo.Kind
+	Name    string
+	// Object is the represented [openslo.Object], inlined objects are converted to their standalone representation.
+	// It is nil if the node represents a referenced object which was not provided to [NewGraph].
+	Object openslo.Object
+	// Inline is true if the object is defined inline by another object.
+	Inline bool
+}
+
+// Missing returns true if the node represents a referenced object which was not provided to [NewGraph].
+func (n GraphNode) Missing() bool {
+	return n.Object == nil
+}
+
+// String returns a pretty-formatted name of the represented object, e.g. "v1.SLO 'my-slo'".
+func (n GraphNode) String() string {
+	return internal.FormatObjectName(n.Version, n.Kind, n.Name)
+}
+
+// GraphEdge represents a link between two nodes of a [Graph].
+// The edge points from the linking object to the linked object, that is, from the dependent to its dependency.
+type GraphEdge struct {
+	// From is the ID of the linking [GraphNode].
+	From string
+	// To is the ID of the linked [GraphNode].
+	To   string
+	Type GraphEdgeType
+	// Path is the property path of the link, relative to the root of the linking object.
+	Path string
+}
+
+// Graph is a directed graph of dependencies between [openslo.Object].
+// Use [NewGraph] to create it.
+type Graph struct {
+	nodes     []*GraphNode
+	nodeIndex map[string]*GraphNode
+	edges     []GraphEdge
+	// outgoing and incoming map node IDs to their edges.
+	outgoing map[string][]GraphEdge
+	incoming map[string][]GraphEdge
+}
+
+// NewGraph creates a new [Graph] from the provided objects.
+// Every object becomes a [GraphNode], along with the objects it defines inline.
+// References to objects which were not provided are represented by nodes for which [GraphNode.Missing] is true.
+// If more than one object has the same version, kind and name, only the first one is used.
+func NewGraph(objects ...openslo.Object) *Graph {
+	g := &Graph{
+		nodeIndex: make(map[string]*GraphNode, len(objects)),
+		outgoing:  make(map[string][]GraphEdge, len(objects)),
+		incoming:  make(map[string][]GraphEdge, len(objects)),
+	}
+	roots := make([]*GraphNode, 0, len(objects))
+	for _, object := range objects {
+		id := graphNodeID(object.GetVersion(), object.GetKind(), object.GetName())
+		if _, ok := g.nodeIndex[id]; ok {
+			continue
+		}
+		roots = append(roots, g.addNode(&GraphNode{
+			ID:      id,
+			Version: object.GetVersion(),
+			Kind:    object.GetKind(),
+			Name:    object.GetName(),
+			Object:  object,
+		}))
+	}
+	for _, root := range roots {
+		g.addLinks(root, root.ID+"#")
+	}
+	return g
+}
+
+// Nodes returns all the nodes of the [Graph], in the order they were added.
+func (g *Graph) Nodes() []GraphNode {
+	nodes := make([]GraphNode, 0, len(g.nodes))
+	for _, node := range g.nodes {
+		nodes = append(nodes, *node)
+	}
+	return nodes
+}
+
+// Node returns the [GraphNode] with the provided ID.
+// If it does not exist, false is returned.
+func (g *Graph) Node(id string) (GraphNode, bool) {
+	node, ok := g.nodeIndex[id]
+	if !ok {
+		return GraphNode{}, false
+	}
+	return *node, true
+}
+
+// Edges returns all the edges of the [Graph].
+func (g *Graph) Edges() []GraphEdge {
+	edges := make([]GraphEdge, len(g.edges))
+	copy(edges, g.edges)
+	return edges
+}
+
+// OutgoingEdges returns the edges pointing from the node with the provided ID to its dependencies.
+func (g *Graph) OutgoingEdges(id string) []GraphEdge {
+	return slices.Clone(g.outgoing[id])
+}
+
+// IncomingEdges returns the edges pointing to the node with the provided ID from its dependents.
+func (g *Graph) IncomingEdges(id string) []GraphEdge {
+	return slices.Clone(g.incoming[id])
+}
+
+// Dependents returns all the nodes which directly or transitively depend on the node with the provided ID,
+// in the order they were added to the [Graph].
+// It answers the question of which objects are impacted if the object represented by the node is changed or removed.
+// The node itself is not included.
+func (g *Graph) Dependents(id string) []GraphNode {
+	visited := map[string]bool{id: true}
+	queue := []string{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, edge := range g.incoming[current] {
+			if visited[edge.From] {
+				continue
+			}
+			visited[edge.From] = true
+			queue = append(queue, edge.From)
+		}
+	}
+	var dependents []GraphNode
+	for _, node := range g.nodes {
+		if node.ID != id && visited[node.ID] {
+			dependents = append(dependents, *node)
+		}
+	}
+	return dependents
+}
+
+// Orphans returns the standalone nodes which are not referenced by any other node, grouped by their kind.
+// Only the objects which can be referenced are considered, for instance, [openslo.KindSLO] is never reported,
+// nor are objects of versions and kinds which are not built into the SDK.
+// Inlined and missing nodes are never orphans.
+func (g *Graph) Orphans() map[openslo.Kind][]GraphNode {
+	orphans := make(map[openslo.Kind][]GraphNode)
+	for _, node := range g.nodes {
+		if node.Inline || node.Missing() || !isReferenceable(node.Version, node.Kind) {
+			continue
+		}
+		if len(g.incoming[node.ID]) == 0 {
+			orphans[node.Kind] = append(orphans[node.Kind], *node)
+		}
+	}
+	return orphans
+}
+
+// TopologicalOrder returns the nodes of the [Graph] ordered so that every node comes after all its dependencies.
+// For instance, a Service comes before the SLO which references it.
+// Nodes which do not depend on each other retain the order in which they were added.
+// An error is returned if the [Graph] contains a cycle.
+func (g *Graph) TopologicalOrder() ([]GraphNode, error) {
+	dependencies := make(map[string]int, len(g.nodes))
+	for _, node := range g.nodes {
+		dependencies[node.ID] = len(g.outgoing[node.ID])
+	}
+	ordered := make([]GraphNode, 0, len(g.nodes))
+	visited := make(map[string]bool, len(g.nodes))
+	for len(ordered) < len(g.nodes) {
+		progressed := false
+		for _, node := range g.nodes {
+			if visited[node.ID] || dependencies[node.ID] > 0 {
+				continue
+			}
+			visited[node.ID] = true
+			ordered = append(ordered, *node)
+			progressed = true
+			for _, edge := range g.incoming[node.ID] {
+				dependencies[edge.From]--
+			}
+		}
+		if !progressed {
+			var cycle []string
+			for _, node := range g.nodes {
+				if !visited[node.ID] {
+					cycle = append(cycle, node.String())
+				}
+			}
+			return nil, fmt.Errorf("graph contains a cycle between: %s", strings.Join(cycle, ", "))
+		}
+	}
+	return ordered, nil
+}
+
+// EncodeDOT writes the [Graph] in the Graphviz DOT language.
+// Inline edges are dashed and missing nodes are colored red.
+func (g *Graph) EncodeDOT(out io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph openslo {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, node := range g.nodes {
+		attrs := fmt.Sprintf("label=%s", quoteDOT(node.String()))
+		switch {
+		case node.Missing():
+			attrs += ", color=red, style=dashed"
+		case node.Inline:
+			attrs += ", style=rounded"
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", quoteDOT(node.ID), attrs)
+	}
+	for _, edge := range g.edges {
+		attrs := fmt.Sprintf("label=%s", quoteDOT(edge.Path))
+		if edge.Type == GraphEdgeTypeInline {
+			attrs += ", style=dashed"
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", quoteDOT(edge.From), quoteDOT(edge.To), attrs)
+	}
+	b.WriteString("}\n")
+	if _, err := io.WriteString(out, b.String()); err != nil {
+		return fmt.Errorf("failed to write DOT graph: %w", err)
+	}
+	return nil
+}
+
+// EncodeMermaid writes the [Graph] as a Mermaid flowchart.
+// Inline edges are dotted and missing nodes are styled with the 'missing' class.
+func (g *Graph) EncodeMermaid(out io.Writer) error {
+	ids := make(map[string]string, len(g.nodes))
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	var missing []string
+	for i, node := range g.nodes {
+		id := fmt.Sprintf("n%d", i)
+		ids[node.ID] = id
+		if node.Inline {
+			fmt.Fprintf(&b, "  %s(%s)\n", id, quoteMermaid(node.String()))
+		} else {
+			fmt.Fprintf(&b, "  %s[%s]\n", id, quoteMermaid(node.String()))
+		}
+		if node.Missing() {
+			missing = append(missing, id)
+		}
+	}
+	for _, edge := range g.edges {
+		arrow := "-->"
+		if edge.Type == GraphEdgeTypeInline {
+			arrow = "-.->"
+		}
+		fmt.Fprintf(&b, "  %s %s|%s| %s\n", ids[edge.From], arrow, quoteMermaid(edge.Path), ids[edge.To])
+	}
+	if len(missing) > 0 {
+		b.WriteString("  classDef missing stroke:#f00,stroke-dasharray:5 5\n")
+		fmt.Fprintf(&b, "  class %s missing\n", strings.Join(missing, ","))
+	}
+	if _, err := io.WriteString(out, b.String()); err != nil {
+		return fmt.Errorf("failed to write Mermaid graph: %w", err)
+	}
+	return nil
+}
+
+func (g *Graph) addNode(node *GraphNode) *GraphNode {
+	g.nodes = append(g.nodes, node)
+	g.nodeIndex[node.ID] = node
+	return node
+}
+
+// addLinks adds the nodes and edges of all the links defined by the node's object.
+// Inlined objects are traversed recursively, idPrefix is used to construct their IDs.
+func (g *Graph) addLinks(node *GraphNode, idPrefix string) {
+	for _, link := range getObjectLinks(node.Object) {
+		edge := GraphEdge{From: node.ID, Type: GraphEdgeTypeReference, Path: link.path}
+		if link.inline {
+			inlined := g.addNode(&GraphNode{
+				ID:      idPrefix + link.path,
+				Version: link.object.GetVersion(),
+				Kind:    link.object.GetKind(),
+				Name:    link.name,
+				Object:  link.object,
+				Inline:  true,
+			})
+			edge.To = inlined.ID
+			edge.Type = GraphEdgeTypeInline
+			g.addEdge(edge)
+			g.addLinks(inlined, idPrefix+link.path+".")
+			continue
+		}
+		id := graphNodeID(link.object.GetVersion(), link.object.GetKind(), link.name)
+		if _, ok := g.nodeIndex[id]; !ok {
+			g.addNode(&GraphNode{
+				ID:      id,
+				Version: link.object.GetVersion(),
+				Kind:    link.object.GetKind(),
+				Name:    link.name,
+			})
+		}
+		edge.To = id
+		g.addEdge(edge)
+	}
+}
+
+func (g *Graph) addEdge(edge GraphEdge) {
+	g.edges = append(g.edges, edge)
+	g.outgoing[edge.From] = append(g.outgoing[edge.From], edge)
+	g.incoming[edge.To] = append(g.incoming[edge.To], edge)
+}
+
+func graphNodeID(version openslo.Version, kind openslo.Kind, name string) string {
+	return fmt.Sprintf("%s/%s/%s", version, kind, name)
+}
+
+func quoteDOT(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func quoteMermaid(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
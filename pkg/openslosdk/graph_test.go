@@ -0,0 +1,226 @@
+package openslosdk
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+)
+
+func TestNewGraph(t *testing.T) {
+	graph := newTestGraph(t)
+
+	type nodeSummary struct {
+		ID      string
+		Inline  bool
+		Missing bool
+	}
+	var nodes []nodeSummary
+	for _, node := range graph.Nodes() {
+		nodes = append(nodes, nodeSummary{ID: node.ID, Inline: node.Inline, Missing: node.Missing()})
+	}
+	assert.Equal(t, []nodeSummary{
+		{ID: "openslo/v1/SLO/web-latency"},
+		{ID: "openslo/v1/Service/web"},
+		{ID: "openslo/v1/DataSource/prometheus"},
+		{ID: "openslo/v1/AlertPolicy/page-on-burn"},
+		{ID: "openslo/v1/AlertCondition/fast-burn"},
+		{ID: "openslo.com/v2alpha/SLO/web-availability"},
+		{ID: "openslo/v1/SLO/web-latency#spec.indicator", Inline: true},
+		{ID: "openslo/v1/SLO/web-latency#spec.alertPolicies[1]", Inline: true},
+		{ID: "openslo/v1/AlertCondition/slow-burn", Missing: true},
+		{ID: "openslo/v1/AlertNotificationTarget/ticket-queue", Missing: true},
+		{ID: "openslo/v1/AlertPolicy/page-on-burn#spec.notificationTargets[0]", Inline: true},
+		{ID: "openslo.com/v2alpha/Service/web", Missing: true},
+		{ID: "openslo.com/v2alpha/SLO/web-availability#spec.sli", Inline: true},
+		{
+			ID:     "openslo.com/v2alpha/SLO/web-availability#spec.sli.spec.ratioMetric.good.dataSourceSpec",
+			Inline: true,
+		},
+		{ID: "openslo.com/v2alpha/DataSource/prometheus", Missing: true},
+	}, nodes)
+
+	indicator, ok := graph.Node("openslo/v1/SLO/web-latency#spec.indicator")
+	assert.Require(t, assert.True(t, ok))
+	assert.Equal(t, "v1.SLI 'web-latency-sli'", indicator.String())
+	assert.Equal(t, openslo.KindSLI, indicator.Object.GetKind())
+	_, ok = graph.Node("openslo/v1/SLI/web-latency-sli")
+	assert.False(t, ok)
+
+	assert.Equal(t, []GraphEdge{
+		{
+			From: "openslo/v1/SLO/web-latency#spec.indicator",
+			To:   "openslo/v1/DataSource/prometheus",
+			Type: GraphEdgeTypeReference,
+			Path: "spec.thresholdMetric.metricSource.metricSourceRef",
+		},
+	}, graph.OutgoingEdges("openslo/v1/SLO/web-latency#spec.indicator"))
+	assert.Equal(t, []GraphEdge{
+		{
+			From: "openslo/v1/SLO/web-latency",
+			To:   "openslo/v1/Service/web",
+			Type: GraphEdgeTypeReference,
+			Path: "spec.service",
+		},
+	}, graph.IncomingEdges("openslo/v1/Service/web"))
+	assert.Len(t, graph.Edges(), 13)
+}
+
+func TestNewGraph_Duplicates(t *testing.T) {
+	objects := decodeTestObjects(t, "validate_set/duplicates.yaml")
+
+	graph := NewGraph(objects...)
+
+	var ids []string
+	for _, node := range graph.Nodes() {
+		ids = append(ids, node.ID)
+	}
+	assert.Equal(t, []string{
+		"openslo/v1/Service/web",
+		"openslo/v1/SLO/web",
+		"openslo.com/v2alpha/Service/web",
+		"openslo/v1/SLO/web#spec.indicator",
+	}, ids)
+	service, ok := graph.Node("openslo/v1/Service/web")
+	assert.Require(t, assert.True(t, ok))
+	assert.Equal(t, objects[0], service.Object)
+	assert.Len(t, graph.IncomingEdges("openslo/v1/Service/web"), 1)
+}
+
+func TestGraph_TopologicalOrder(t *testing.T) {
+	graph := newTestGraph(t)
+
+	ordered, err := graph.TopologicalOrder()
+	assert.Require(t, assert.NoError(t, err))
+
+	var ids []string
+	for _, node := range ordered {
+		ids = append(ids, node.ID)
+	}
+	assert.Equal(t, []string{
+		"openslo/v1/Service/web",
+		"openslo/v1/DataSource/prometheus",
+		"openslo/v1/AlertCondition/fast-burn",
+		"openslo/v1/SLO/web-latency#spec.indicator",
+		"openslo/v1/AlertCondition/slow-burn",
+		"openslo/v1/AlertNotificationTarget/ticket-queue",
+		"openslo/v1/AlertPolicy/page-on-burn#spec.notificationTargets[0]",
+		"openslo.com/v2alpha/Service/web",
+		"openslo.com/v2alpha/SLO/web-availability#spec.sli.spec.ratioMetric.good.dataSourceSpec",
+		"openslo.com/v2alpha/DataSource/prometheus",
+		"openslo/v1/AlertPolicy/page-on-burn",
+		"openslo/v1/SLO/web-latency#spec.alertPolicies[1]",
+		"openslo.com/v2alpha/SLO/web-availability#spec.sli",
+		"openslo/v1/SLO/web-latency",
+		"openslo.com/v2alpha/SLO/web-availability",
+	}, ids)
+}
+
+func TestGraph_Dependents(t *testing.T) {
+	graph := newTestGraph(t)
+
+	tests := map[string]struct {
+		id       string
+		expected []string
+	}{
+		"data source referenced by an inline SLI": {
+			id: "openslo/v1/DataSource/prometheus",
+			expected: []string{
+				"openslo/v1/SLO/web-latency",
+				"openslo/v1/SLO/web-latency#spec.indicator",
+			},
+		},
+		"alert notification target inlined in an alert policy": {
+			id: "openslo/v1/AlertPolicy/page-on-burn#spec.notificationTargets[0]",
+			expected: []string{
+				"openslo/v1/SLO/web-latency",
+				"openslo/v1/AlertPolicy/page-on-burn",
+			},
+		},
+		"missing alert condition": {
+			id: "openslo/v1/AlertCondition/slow-burn",
+			expected: []string{
+				"openslo/v1/SLO/web-latency",
+				"openslo/v1/SLO/web-latency#spec.alertPolicies[1]",
+			},
+		},
+		"missing data source referenced by v2alpha SLI": {
+			id: "openslo.com/v2alpha/DataSource/prometheus",
+			expected: []string{
+				"openslo.com/v2alpha/SLO/web-availability",
+				"openslo.com/v2alpha/SLO/web-availability#spec.sli",
+			},
+		},
+		"SLO has no dependents": {
+			id: "openslo/v1/SLO/web-latency",
+		},
+		"unknown node": {
+			id: "openslo/v1/Service/unknown",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var ids []string
+			for _, node := range graph.Dependents(tc.id) {
+				ids = append(ids, node.ID)
+			}
+			assert.Equal(t, tc.expected, ids)
+		})
+	}
+}
+
+func TestGraph_Orphans(t *testing.T) {
+	t.Run("no orphans", func(t *testing.T) {
+		graph := newTestGraph(t)
+
+		assert.Len(t, graph.Orphans(), 0)
+	})
+	t.Run("orphans", func(t *testing.T) {
+		graph := NewGraph(decodeTestObjects(t, "graph/orphans.yaml")...)
+
+		orphans := make(map[openslo.Kind][]string)
+		for kind, nodes := range graph.Orphans() {
+			for _, node := range nodes {
+				orphans[kind] = append(orphans[kind], node.ID)
+			}
+		}
+		assert.Equal(t, map[openslo.Kind][]string{
+			openslo.KindService:                 {"openslo/v1/Service/unused-service"},
+			openslo.KindSLI:                     {"openslo/v1/SLI/unused-sli"},
+			openslo.KindAlertCondition:          {"openslo/v1/AlertCondition/unused-condition"},
+			openslo.KindDataSource:              {"openslo.com/v2alpha/DataSource/unused-prometheus"},
+			openslo.KindAlertNotificationTarget: {"openslo.com/v2alpha/AlertNotificationTarget/unused-target"},
+		}, orphans)
+	})
+}
+
+func TestGraph_EncodeDOT(t *testing.T) {
+	graph := newTestGraph(t)
+
+	var buf bytes.Buffer
+	err := graph.EncodeDOT(&buf)
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, string(readTestData(t, testData, "graph/objects.dot")), buf.String())
+}
+
+func TestGraph_EncodeMermaid(t *testing.T) {
+	graph := newTestGraph(t)
+
+	var buf bytes.Buffer
+	err := graph.EncodeMermaid(&buf)
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, string(readTestData(t, testData, "graph/objects.mmd")), buf.String())
+}
+
+func newTestGraph(t *testing.T) *Graph {
+	t.Helper()
+	return NewGraph(decodeTestObjects(t, "graph/objects.yaml")...)
+}
+
+func decodeTestObjects(t *testing.T, path string) []openslo.Object {
+	t.Helper()
+	objects, err := Decode(bytes.NewReader(readTestData(t, testData, path)), FormatYAML)
+	assert.Require(t, assert.NoError(t, err))
+	return objects
+}
@@ -0,0 +1,302 @@
+package openslosdk
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nobl9/govy/pkg/rules"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+// LabelSelector matches [openslo.Object] by its 'metadata.labels'.
+// It follows the syntax of Kubernetes label selectors, see [ParseLabelSelector].
+// The zero value matches every object.
+type LabelSelector struct {
+	requirements []labelRequirement
+}
+
+// ParseLabelSelector parses a comma-separated list of label requirements, all of which must be met
+// for an object to match the [LabelSelector].
+// The following requirements are supported:
+//   - 'key=value' or 'key==value', the label has the value
+//   - 'key!=value', the label does not exist or does not have the value
+//   - 'key in (value1,value2)', the label has any of the values
+//   - 'key notin (value1,value2)', the label does not exist or has none of the values
+//   - 'key', the label exists
+//   - '!key', the label does not exist
+//
+// Keys must be valid Kubernetes qualified names and value sets of 'in' and 'notin' must not contain empty values.
+// Labels of [v1.Object] can have multiple values, a value requirement is met if any of them matches.
+// Example:
+//
+//	env=prod,tier in (1,2),!deprecated
+func ParseLabelSelector(s string) (LabelSelector, error) {
+	p := labelSelectorParser{input: s}
+	requirements, err := p.parse()
+	if err != nil {
+		return LabelSelector{}, fmt.Errorf("invalid label selector '%s': %w", s, err)
+	}
+	return LabelSelector{requirements: requirements}, nil
+}
+
+// MustParseLabelSelector is like [ParseLabelSelector] but panics if the selector is invalid.
+func MustParseLabelSelector(s string) LabelSelector {
+	selector, err := ParseLabelSelector(s)
+	if err != nil {
+		panic(err)
+	}
+	return selector
+}
+
+// Matches reports whether the labels of the [openslo.Object] meet all requirements of the [LabelSelector].
+// Objects which do not define labels, like [v1alpha.Object], are treated as objects with no labels.
+func (s LabelSelector) Matches(object openslo.Object) bool {
+	labels := getObjectLabels(object)
+	for _, requirement := range s.requirements {
+		if !requirement.matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty reports whether the [LabelSelector] has no requirements, in which case it matches every object.
+func (s LabelSelector) Empty() bool {
+	return len(s.requirements) == 0
+}
+
+// String returns the canonical representation of the [LabelSelector],
+// which can be parsed with [ParseLabelSelector].
+func (s LabelSelector) String() string {
+	parts := make([]string, 0, len(s.requirements))
+	for _, requirement := range s.requirements {
+		parts = append(parts, requirement.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+type labelOperator string
+
+const (
+	labelOperatorEquals       labelOperator = "="
+	labelOperatorNotEquals    labelOperator = "!="
+	labelOperatorIn           labelOperator = "in"
+	labelOperatorNotIn        labelOperator = "notin"
+	labelOperatorExists       labelOperator = "exists"
+	labelOperatorDoesNotExist labelOperator = "!"
+)
+
+type labelRequirement struct {
+	key      string
+	operator labelOperator
+	values   []string
+}
+
+func (r labelRequirement) matches(labels map[string][]string) bool {
+	values, exists := labels[r.key]
+	switch r.operator {
+	case labelOperatorExists:
+		return exists
+	case labelOperatorDoesNotExist:
+		return !exists
+	case labelOperatorEquals, labelOperatorIn:
+		return exists && r.hasAnyValue(values)
+	case labelOperatorNotEquals, labelOperatorNotIn:
+		return !exists || !r.hasAnyValue(values)
+	default:
+		return false
+	}
+}
+
+func (r labelRequirement) hasAnyValue(values []string) bool {
+	for _, value := range values {
+		if slices.Contains(r.values, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r labelRequirement) String() string {
+	switch r.operator {
+	case labelOperatorExists:
+		return r.key
+	case labelOperatorDoesNotExist:
+		return "!" + r.key
+	case labelOperatorIn, labelOperatorNotIn:
+		return fmt.Sprintf("%s %s (%s)", r.key, r.operator, strings.Join(r.values, ","))
+	default:
+		return r.key + string(r.operator) + r.values[0]
+	}
+}
+
+// getObjectLabels returns the labels of the [openslo.Object] with every label represented as a list of values.
+func getObjectLabels(object openslo.Object) map[string][]string {
+	switch v := object.(type) {
+	case v1.Object:
+		labels := v.GetMetadata().Labels
+		result := make(map[string][]string, len(labels))
+		for key, values := range labels {
+			result[key] = values
+		}
+		return result
+	case v2alpha.Object:
+		labels := v.GetMetadata().Labels
+		result := make(map[string][]string, len(labels))
+		for key, value := range labels {
+			result[key] = []string{value}
+		}
+		return result
+	default:
+		return nil
+	}
+}
+
+// labelSelectorParser is a simple recursive descent parser of the [LabelSelector] syntax.
+type labelSelectorParser struct {
+	input string
+	pos   int
+}
+
+func (p *labelSelectorParser) parse() ([]labelRequirement, error) {
+	var requirements []labelRequirement
+	if p.skipSpaces(); p.done() {
+		return nil, nil
+	}
+	for {
+		requirement, err := p.parseRequirement()
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, requirement)
+		if p.skipSpaces(); p.done() {
+			return requirements, nil
+		}
+		if !p.consume(",") {
+			return nil, p.errorf("expected ','")
+		}
+	}
+}
+
+func (p *labelSelectorParser) parseRequirement() (labelRequirement, error) {
+	p.skipSpaces()
+	if p.consume("!") {
+		key, err := p.parseKey()
+		if err != nil {
+			return labelRequirement{}, err
+		}
+		return labelRequirement{key: key, operator: labelOperatorDoesNotExist}, nil
+	}
+	key, err := p.parseKey()
+	if err != nil {
+		return labelRequirement{}, err
+	}
+	p.skipSpaces()
+	var operator labelOperator
+	switch {
+	case p.done() || p.peek(","):
+		return labelRequirement{key: key, operator: labelOperatorExists}, nil
+	case p.consume("=="), p.consume("="):
+		operator = labelOperatorEquals
+	case p.consume("!="):
+		operator = labelOperatorNotEquals
+	default:
+		word := p.parseWord()
+		switch labelOperator(word) {
+		case labelOperatorIn, labelOperatorNotIn:
+			values, err := p.parseValueSet()
+			if err != nil {
+				return labelRequirement{}, err
+			}
+			return labelRequirement{key: key, operator: labelOperator(word), values: values}, nil
+		default:
+			return labelRequirement{}, p.errorf("expected one of '=', '==', '!=', 'in', 'notin' after '%s'", key)
+		}
+	}
+	p.skipSpaces()
+	return labelRequirement{key: key, operator: operator, values: []string{p.parseWord()}}, nil
+}
+
+func (p *labelSelectorParser) parseValueSet() ([]string, error) {
+	p.skipSpaces()
+	if !p.consume("(") {
+		return nil, p.errorf("expected '('")
+	}
+	var values []string
+	for {
+		p.skipSpaces()
+		value := p.parseWord()
+		if value == "" {
+			return nil, p.errorf("expected label value")
+		}
+		values = append(values, value)
+		p.skipSpaces()
+		switch {
+		case p.consume(")"):
+			return values, nil
+		case p.consume(","):
+		default:
+			return nil, p.errorf("expected ',' or ')'")
+		}
+	}
+}
+
+func (p *labelSelectorParser) parseKey() (string, error) {
+	p.skipSpaces()
+	start := p.pos
+	key := p.parseWord()
+	if key == "" {
+		return "", p.errorf("expected label key")
+	}
+	if err := rules.StringKubernetesQualifiedName().Validate(key); err != nil {
+		p.pos = start
+		return "", p.errorf("label key '%s' is not a valid Kubernetes qualified name", key)
+	}
+	return key, nil
+}
+
+// parseWord consumes a label key or value, which ends at a whitespace or any of the special characters.
+func (p *labelSelectorParser) parseWord() string {
+	start := p.pos
+	for !p.done() {
+		if c := p.input[p.pos]; isLabelSelectorSpace(c) || strings.IndexByte("=!(),", c) >= 0 {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *labelSelectorParser) skipSpaces() {
+	for !p.done() && isLabelSelectorSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *labelSelectorParser) consume(token string) bool {
+	if p.peek(token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *labelSelectorParser) peek(token string) bool {
+	return strings.HasPrefix(p.input[p.pos:], token)
+}
+
+func (p *labelSelectorParser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *labelSelectorParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), p.pos)
+}
+
+func isLabelSelectorSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
@@ -0,0 +1,153 @@
+package openslosdk
+
+import (
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v1alpha"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected string
+		err      string
+	}{
+		"empty": {
+			input:    "  ",
+			expected: "",
+		},
+		"equals": {
+			input:    "env=prod",
+			expected: "env=prod",
+		},
+		"double equals": {
+			input:    "env == prod",
+			expected: "env=prod",
+		},
+		"not equals": {
+			input:    "env!=prod",
+			expected: "env!=prod",
+		},
+		"empty value": {
+			input:    "env=",
+			expected: "env=",
+		},
+		"in": {
+			input:    "tier in ( 1, 2 )",
+			expected: "tier in (1,2)",
+		},
+		"notin": {
+			input:    "tier notin (1)",
+			expected: "tier notin (1)",
+		},
+		"exists": {
+			input:    "deprecated",
+			expected: "deprecated",
+		},
+		"does not exist": {
+			input:    "! deprecated",
+			expected: "!deprecated",
+		},
+		"multiple requirements": {
+			input:    "env=prod, tier in (1,2),!deprecated,openslo.com/team",
+			expected: "env=prod,tier in (1,2),!deprecated,openslo.com/team",
+		},
+		"missing key": {
+			input: "=prod",
+			err:   "invalid label selector '=prod': expected label key at position 0",
+		},
+		"trailing comma": {
+			input: "env=prod,",
+			err:   "invalid label selector 'env=prod,': expected label key at position 9",
+		},
+		"unknown operator": {
+			input: "env has prod",
+			err: "invalid label selector 'env has prod':" +
+				" expected one of '=', '==', '!=', 'in', 'notin' after 'env' at position 7",
+		},
+		"missing value set": {
+			input: "tier in 1",
+			err:   "invalid label selector 'tier in 1': expected '(' at position 8",
+		},
+		"unclosed value set": {
+			input: "tier in (1,2",
+			err:   "invalid label selector 'tier in (1,2': expected ',' or ')' at position 12",
+		},
+		"missing comma": {
+			input: "env=prod tier=1",
+			err:   "invalid label selector 'env=prod tier=1': expected ',' at position 9",
+		},
+		"empty value set": {
+			input: "tier in ()",
+			err:   "invalid label selector 'tier in ()': expected label value at position 9",
+		},
+		"empty value in set": {
+			input: "tier notin (1,,2)",
+			err:   "invalid label selector 'tier notin (1,,2)': expected label value at position 14",
+		},
+		"trailing comma in set": {
+			input: "tier in (1,)",
+			err:   "invalid label selector 'tier in (1,)': expected label value at position 11",
+		},
+		"invalid key": {
+			input: "env=prod,-tier=1",
+			err: "invalid label selector 'env=prod,-tier=1':" +
+				" label key '-tier' is not a valid Kubernetes qualified name at position 9",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			selector, err := ParseLabelSelector(tc.input)
+			if tc.err != "" {
+				assert.Require(t, assert.Error(t, err))
+				assert.Equal(t, tc.err, err.Error())
+				return
+			}
+			assert.Require(t, assert.NoError(t, err))
+			assert.Equal(t, tc.expected, selector.String())
+			assert.Equal(t, tc.expected == "", selector.Empty())
+		})
+	}
+}
+
+func TestLabelSelector_Matches(t *testing.T) {
+	v1Object := v1.SLO{Metadata: v1.Metadata{
+		Name:   "slo",
+		Labels: v1.Labels{"env": {"prod", "staging"}, "tier": {"1"}},
+	}}
+	v2alphaObject := v2alpha.SLO{Metadata: v2alpha.Metadata{
+		Name:   "slo",
+		Labels: v2alpha.Labels{"env": "prod", "tier": "1"},
+	}}
+	v1alphaObject := v1alpha.SLO{Metadata: v1alpha.Metadata{Name: "slo"}}
+
+	tests := map[string]struct {
+		selector string
+		v1       bool
+		v2alpha  bool
+		v1alpha  bool
+	}{
+		"empty":                         {selector: "", v1: true, v2alpha: true, v1alpha: true},
+		"equals":                        {selector: "env=prod", v1: true, v2alpha: true},
+		"equals any of multiple values": {selector: "env=staging", v1: true},
+		"not equals":                    {selector: "env!=staging", v2alpha: true, v1alpha: true},
+		"not equals missing label":      {selector: "team!=a", v1: true, v2alpha: true, v1alpha: true},
+		"in":                            {selector: "tier in (1,2)", v1: true, v2alpha: true},
+		"notin":                         {selector: "tier notin (1,2)", v1alpha: true},
+		"exists":                        {selector: "tier", v1: true, v2alpha: true},
+		"does not exist":                {selector: "!deprecated", v1: true, v2alpha: true, v1alpha: true},
+		"all requirements":              {selector: "env=prod,tier in (1,2),!deprecated", v1: true, v2alpha: true},
+		"one of requirements not met":   {selector: "env=prod,tier in (2,3)"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			selector := MustParseLabelSelector(tc.selector)
+			assert.Equal(t, tc.v1, selector.Matches(v1Object))
+			assert.Equal(t, tc.v2alpha, selector.Matches(v2alphaObject))
+			assert.Equal(t, tc.v1alpha, selector.Matches(v1alphaObject))
+		})
+	}
+}
@@ -1,6 +1,15 @@
 package openslosdk
 
-import "github.com/OpenSLO/go-sdk/pkg/openslo"
+import (
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v1alpha"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
 
 // FilterByType filters [openslo.Object] slice and returns its subset matching the type constraint.
 // You can use it to filter:
@@ -15,3 +24,100 @@ func FilterByType[T openslo.Object](objects []openslo.Object) []T {
 	}
 	return filtered
 }
+
+// ObjectFilter defines the criteria an [openslo.Object] has to meet in order to be selected by [Filter].
+// All criteria are optional, the ones which are not set match every object.
+type ObjectFilter struct {
+	// Versions lists the accepted [openslo.Version] values.
+	Versions []openslo.Version
+	// Kinds lists the accepted [openslo.Kind] values.
+	Kinds []openslo.Kind
+	// Name is a glob pattern the name of the object has to match, e.g. 'web-*'.
+	// The pattern syntax is the same as for [path.Match].
+	Name string
+	// Labels is a [LabelSelector] the labels of the object have to match.
+	Labels LabelSelector
+}
+
+// Filter returns the subset of [openslo.Object] slice which meets all the criteria of the [ObjectFilter].
+// The order of the objects is preserved.
+// An error is returned if [ObjectFilter.Name] is not a valid pattern.
+func Filter(objects []openslo.Object, filter ObjectFilter) ([]openslo.Object, error) {
+	if _, err := path.Match(filter.Name, ""); err != nil {
+		return nil, fmt.Errorf("invalid name pattern '%s': %w", filter.Name, err)
+	}
+	var filtered []openslo.Object
+	for _, object := range objects {
+		if filter.matches(object) {
+			filtered = append(filtered, object)
+		}
+	}
+	return filtered, nil
+}
+
+func (f ObjectFilter) matches(object openslo.Object) bool {
+	if len(f.Versions) > 0 && !slices.Contains(f.Versions, object.GetVersion()) {
+		return false
+	}
+	if len(f.Kinds) > 0 && !slices.Contains(f.Kinds, object.GetKind()) {
+		return false
+	}
+	if f.Name != "" {
+		// The pattern is validated by Filter.
+		if matched, _ := path.Match(f.Name, object.GetName()); !matched {
+			return false
+		}
+	}
+	return f.Labels.Matches(object)
+}
+
+// Normalize returns normalized deep copies of the objects,
+// equivalent forms, like [v1.SLOObjective] target expressed as percentage,
+// are converted to a single, canonical one, see for instance [v1.SLO.Normalize].
+// Objects which do not support normalization, like the registered custom objects, are returned as is.
+func Normalize(objects ...openslo.Object) []openslo.Object {
+	normalized := make([]openslo.Object, 0, len(objects))
+	for _, object := range objects {
+		normalized = append(normalized, normalizeObject(object))
+	}
+	return normalized
+}
+
+func normalizeObject(object openslo.Object) openslo.Object {
+	switch v := object.(type) {
+	case v1alpha.SLO:
+		return v.Normalize()
+	case v1alpha.Service:
+		return v.Normalize()
+	case v1.SLO:
+		return v.Normalize()
+	case v1.SLI:
+		return v.Normalize()
+	case v1.DataSource:
+		return v.Normalize()
+	case v1.Service:
+		return v.Normalize()
+	case v1.AlertPolicy:
+		return v.Normalize()
+	case v1.AlertCondition:
+		return v.Normalize()
+	case v1.AlertNotificationTarget:
+		return v.Normalize()
+	case v2alpha.SLO:
+		return v.Normalize()
+	case v2alpha.SLI:
+		return v.Normalize()
+	case v2alpha.DataSource:
+		return v.Normalize()
+	case v2alpha.Service:
+		return v.Normalize()
+	case v2alpha.AlertPolicy:
+		return v.Normalize()
+	case v2alpha.AlertCondition:
+		return v.Normalize()
+	case v2alpha.AlertNotificationTarget:
+		return v.Normalize()
+	default:
+		return object
+	}
+}
@@ -81,3 +81,78 @@ func TestFilterByType(t *testing.T) {
 type mockObject struct {
 	openslo.Object
 }
+
+func TestNormalize(t *testing.T) {
+	objects := []openslo.Object{
+		v1.Service{Metadata: v1.Metadata{Name: "service", Labels: v1.Labels{"team": {"b", "a", "b"}}}},
+		mockObject{},
+	}
+
+	normalized := Normalize(objects...)
+
+	assert.Equal(t, []openslo.Object{
+		v1.Service{Metadata: v1.Metadata{Name: "service", Labels: v1.Labels{"team": {"a", "b"}}}},
+		mockObject{},
+	}, normalized)
+	assert.Equal(t, v1.Label{"b", "a", "b"}, objects[0].(v1.Service).Metadata.Labels["team"])
+}
+
+func TestFilter(t *testing.T) {
+	objects := decodeTestObjects(t, "filter/objects.yaml")
+
+	tests := map[string]struct {
+		filter   ObjectFilter
+		expected []string
+	}{
+		"empty filter": {
+			filter:   ObjectFilter{},
+			expected: []string{"web", "web-latency", "web-availability", "api-latency", "legacy"},
+		},
+		"versions": {
+			filter:   ObjectFilter{Versions: []openslo.Version{openslo.VersionV1alpha, openslo.VersionV2alpha}},
+			expected: []string{"api-latency", "legacy"},
+		},
+		"kinds": {
+			filter:   ObjectFilter{Kinds: []openslo.Kind{openslo.KindService}},
+			expected: []string{"web"},
+		},
+		"name glob": {
+			filter:   ObjectFilter{Name: "*-latency"},
+			expected: []string{"web-latency", "api-latency"},
+		},
+		"labels": {
+			filter:   ObjectFilter{Labels: MustParseLabelSelector("env=prod,tier in (1,2),!deprecated")},
+			expected: []string{"web-latency", "api-latency"},
+		},
+		"all criteria": {
+			filter: ObjectFilter{
+				Versions: []openslo.Version{openslo.VersionV1},
+				Kinds:    []openslo.Kind{openslo.KindSLO},
+				Name:     "web-*",
+				Labels:   MustParseLabelSelector("team=frontend"),
+			},
+			expected: []string{"web-latency", "web-availability"},
+		},
+		"no matches": {
+			filter:   ObjectFilter{Kinds: []openslo.Kind{openslo.KindDataSource}},
+			expected: nil,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			filtered, err := Filter(objects, tc.filter)
+			assert.Require(t, assert.NoError(t, err))
+			var names []string
+			for _, object := range filtered {
+				names = append(names, object.GetName())
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestFilter_InvalidNamePattern(t *testing.T) {
+	_, err := Filter(nil, ObjectFilter{Name: "web-["})
+	assert.Require(t, assert.Error(t, err))
+	assert.Equal(t, "invalid name pattern 'web-[': syntax error in pattern", err.Error())
+}
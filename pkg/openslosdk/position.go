@@ -0,0 +1,84 @@
+package openslosdk
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nobl9/govy/pkg/govy"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+)
+
+// Position describes the origin of a decoded [openslo.Object] within its source.
+type Position struct {
+	// File is the name of the source file, it is only set if provided with [Decoder.WithFileName].
+	File string `json:"file,omitempty"`
+	// Document is the zero-based index of the YAML document or the top-level JSON value within the stream.
+	Document int `json:"document"`
+	// Index is the zero-based index of the object within the stream.
+	Index int `json:"index"`
+	// Offset is the zero-based byte offset at which the object starts.
+	Offset int64 `json:"offset"`
+	// Line is the one-based line number at which the object starts.
+	Line int `json:"line"`
+}
+
+// String returns the position in the 'file:line' format, or 'line N' if the file name is not known.
+func (p Position) String() string {
+	if p.File != "" {
+		return p.File + ":" + strconv.Itoa(p.Line)
+	}
+	return "line " + strconv.Itoa(p.Line)
+}
+
+// DecodeError is returned by [Decoder] when an object cannot be decoded.
+// It carries the [Position] of the object which failed to decode.
+type DecodeError struct {
+	Position Position
+	Err      error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Position, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeErrors is a list of [*DecodeError] collected when decoding
+// with [DecodeOptions.ContinueOnError] set.
+type DecodeErrors []*DecodeError
+
+func (e DecodeErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// ValidateWithPositions works like [Validate], but it also includes the [Position]
+// of every invalid object in its [govy.ValidatorError] name,
+// e.g. "v1.SLO 'my-slo' (slos.yaml:12)".
+// The positions must correspond to the objects, which is the case when both are collected from [Decoder].
+// The [govy.ValidatorError.SliceIndex] can be used to retrieve the [Position] of a specific error.
+func ValidateWithPositions(objects []openslo.Object, positions []Position) error {
+	if len(objects) != len(positions) {
+		return fmt.Errorf("number of objects (%d) does not match the number of positions (%d)",
+			len(objects), len(positions))
+	}
+	err := Validate(objects...)
+	var vErrs govy.ValidatorErrors
+	if !errors.As(err, &vErrs) {
+		return err
+	}
+	for _, vErr := range vErrs {
+		if vErr.SliceIndex != nil {
+			vErr.Name = fmt.Sprintf("%s (%s)", vErr.Name, positions[*vErr.SliceIndex])
+		}
+	}
+	return vErrs
+}
@@ -4,12 +4,14 @@ package openslosdk
 // It is used both by [ReferenceInliner] and [ReferenceExporter].
 // By default, all references are resolved.
 type ReferenceConfig struct {
-	V1 ReferenceConfigV1
+	V1      ReferenceConfigV1
+	V2alpha ReferenceConfigV2alpha
 }
 
 // ReferenceConfigV1 configures [openslo.VersionV1] references resolution.
 type ReferenceConfigV1 struct {
 	SLO         ReferenceConfigV1SLO
+	SLI         ReferenceConfigV1SLI
 	AlertPolicy ReferenceConfigV1AlertPolicy
 }
 
@@ -21,6 +23,22 @@ type ReferenceConfigV1SLO struct {
 	SLI bool
 }
 
+// ReferenceConfigV1SLI configures [v1.SLI] references resolution.
+// It applies both to standalone [v1.SLI] objects and the ones inlined in [v1.SLO].
+type ReferenceConfigV1SLI struct {
+	// DataSource controls whether [openslo.KindDataSource] references should be resolved.
+	// Since [v1.SLIMetricSource] has no dedicated field for an inlined [v1.DataSource],
+	// only the referenced object's type is copied into the metric source, the reference itself is retained.
+	DataSource bool
+	// MergeConnectionDetails makes [ReferenceInliner] also merge the referenced [v1.DataSource] connection details
+	// into the metric source spec and remove the reference, which makes the metric source self-contained.
+	// Connection details often hold credentials or endpoints, which are then copied into every [v1.SLI],
+	// hence it has to be explicitly enabled.
+	// [ReferenceExporter] restores the reference and removes the merged keys if the [v1.DataSource] is provided.
+	// It has no effect unless DataSource is set.
+	MergeConnectionDetails bool
+}
+
 // ReferenceConfigV1AlertPolicy configures [v1.AlertPolicy] references resolution.
 type ReferenceConfigV1AlertPolicy struct {
 	// AlertPolicy controls whether [openslo.KindAlertCondition] references should be resolved.
@@ -29,6 +47,36 @@ type ReferenceConfigV1AlertPolicy struct {
 	AlertNotificationTarget bool
 }
 
+// ReferenceConfigV2alpha configures [openslo.VersionV2alpha] references resolution.
+type ReferenceConfigV2alpha struct {
+	SLO         ReferenceConfigV2alphaSLO
+	SLI         ReferenceConfigV2alphaSLI
+	AlertPolicy ReferenceConfigV2alphaAlertPolicy
+}
+
+// ReferenceConfigV2alphaSLO configures [v2alpha.SLO] references resolution.
+type ReferenceConfigV2alphaSLO struct {
+	// AlertPolicy controls whether [openslo.KindAlertPolicy] references should be resolved.
+	AlertPolicy bool
+	// SLI controls whether [openslo.KindSLI] references should be resolved.
+	SLI bool
+}
+
+// ReferenceConfigV2alphaSLI configures [v2alpha.SLI] references resolution.
+// It applies both to standalone [v2alpha.SLI] objects and the ones inlined in [v2alpha.SLO].
+type ReferenceConfigV2alphaSLI struct {
+	// DataSource controls whether [openslo.KindDataSource] references should be resolved.
+	DataSource bool
+}
+
+// ReferenceConfigV2alphaAlertPolicy configures [v2alpha.AlertPolicy] references resolution.
+type ReferenceConfigV2alphaAlertPolicy struct {
+	// AlertCondition controls whether [openslo.KindAlertCondition] references should be resolved.
+	AlertCondition bool
+	// AlertNotificationTarget controls whether [openslo.KindAlertNotificationTarget] references should be resolved.
+	AlertNotificationTarget bool
+}
+
 func defaultReferenceConfig() ReferenceConfig {
 	return ReferenceConfig{
 		V1: ReferenceConfigV1{
@@ -36,10 +84,26 @@ func defaultReferenceConfig() ReferenceConfig {
 				AlertPolicy: true,
 				SLI:         true,
 			},
+			SLI: ReferenceConfigV1SLI{
+				DataSource: true,
+			},
 			AlertPolicy: ReferenceConfigV1AlertPolicy{
 				AlertCondition:          true,
 				AlertNotificationTarget: true,
 			},
 		},
+		V2alpha: ReferenceConfigV2alpha{
+			SLO: ReferenceConfigV2alphaSLO{
+				AlertPolicy: true,
+				SLI:         true,
+			},
+			SLI: ReferenceConfigV2alphaSLI{
+				DataSource: true,
+			},
+			AlertPolicy: ReferenceConfigV2alphaAlertPolicy{
+				AlertCondition:          true,
+				AlertNotificationTarget: true,
+			},
+		},
 	}
 }
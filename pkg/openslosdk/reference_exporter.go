@@ -1,10 +1,14 @@
 package openslosdk
 
 import (
+	"encoding/json"
+	"reflect"
+	"slices"
 	"sync"
 
 	"github.com/OpenSLO/go-sdk/pkg/openslo"
 	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
 )
 
 func NewReferenceExporter(objects ...openslo.Object) *ReferenceExporter {
@@ -60,6 +64,8 @@ func (r *ReferenceExporter) exportObject(object openslo.Object) {
 	switch version {
 	case openslo.VersionV1:
 		r.addResult(r.exportV1Object(object)...)
+	case openslo.VersionV2alpha:
+		r.addResult(r.exportV2alphaObject(object)...)
 	default:
 		r.addResult(object)
 	}
@@ -71,6 +77,8 @@ func (r *ReferenceExporter) exportV1Object(object openslo.Object) []openslo.Obje
 		return r.exportV1AlertPolicy(v)
 	case v1.SLO:
 		return r.exportV1SLO(v)
+	case v1.SLI:
+		return r.exportV1SLI(v)
 	default:
 		return []openslo.Object{object}
 	}
@@ -124,6 +132,9 @@ func (r *ReferenceExporter) exportV1SLO(slo v1.SLO) []openslo.Object {
 	if r.config.V1.SLO.AlertPolicy {
 		exported = append(exported, r.exportV1SLOAlertPolicies(&slo)...)
 	}
+	if r.config.V1.SLI.DataSource {
+		r.exportV1SLODataSources(&slo)
+	}
 	if r.config.V1.SLO.SLI {
 		exported = append(exported, r.exportV1SLOSLI(&slo)...)
 	}
@@ -148,15 +159,341 @@ func (r *ReferenceExporter) exportV1SLOAlertPolicies(slo *v1.SLO) []openslo.Obje
 }
 
 func (r *ReferenceExporter) exportV1SLOSLI(slo *v1.SLO) []openslo.Object {
-	if slo.Spec.Indicator == nil {
-		return nil
+	exported := make([]openslo.Object, 0)
+	if slo.Spec.Indicator != nil {
+		exported = append(exported, v1.NewSLI(slo.Spec.Indicator.Metadata, slo.Spec.Indicator.Spec))
+		slo.Spec.IndicatorRef = &slo.Spec.Indicator.Metadata.Name
+		slo.Spec.Indicator = nil
+	}
+	for i, objective := range slo.Spec.Objectives {
+		if objective.Indicator == nil {
+			continue
+		}
+		exported = append(exported, v1.NewSLI(objective.Indicator.Metadata, objective.Indicator.Spec))
+		objective.IndicatorRef = &objective.Indicator.Metadata.Name
+		objective.Indicator = nil
+		slo.Spec.Objectives[i] = objective
+	}
+	return exported
+}
+
+func (r *ReferenceExporter) exportV1SLODataSources(slo *v1.SLO) {
+	if slo.Spec.Indicator != nil {
+		indicator := *slo.Spec.Indicator
+		indicator.Spec = r.exportV1SLISpecDataSources(indicator.Spec)
+		slo.Spec.Indicator = &indicator
+	}
+	for i, objective := range slo.Spec.Objectives {
+		if objective.Indicator == nil {
+			continue
+		}
+		indicator := *objective.Indicator
+		indicator.Spec = r.exportV1SLISpecDataSources(indicator.Spec)
+		objective.Indicator = &indicator
+		slo.Spec.Objectives[i] = objective
+	}
+}
+
+func (r *ReferenceExporter) exportV1SLI(sli v1.SLI) []openslo.Object {
+	if r.config.V1.SLI.DataSource {
+		sli.Spec = r.exportV1SLISpecDataSources(sli.Spec)
 	}
-	sli := v1.NewSLI(slo.Spec.Indicator.Metadata, slo.Spec.Indicator.Spec)
-	slo.Spec.IndicatorRef = &slo.Spec.Indicator.Metadata.Name
-	slo.Spec.Indicator = nil
 	return []openslo.Object{sli}
 }
 
+// exportV1SLISpecDataSources restores the [v1.DataSource] references of the metric sources
+// whose connection details were merged by [ReferenceInliner], see [ReferenceConfigV1SLI.MergeConnectionDetails].
+// A metric source without a reference matches a [v1.DataSource] provided to the [ReferenceExporter]
+// if both have the same type and the metric source spec contains all the connection details.
+// The merged keys are removed from the spec.
+// If none or more than one [v1.DataSource] matches, the metric source is left unchanged.
+func (r *ReferenceExporter) exportV1SLISpecDataSources(spec v1.SLISpec) v1.SLISpec {
+	for _, metric := range getV1SLIMetricSpecs(&spec) {
+		source := (*metric.spec).MetricSource
+		if source.MetricSourceRef != "" {
+			continue
+		}
+		name, details, ok := r.findV1MergedDataSource(source)
+		if !ok {
+			continue
+		}
+		exported := v1.SLIMetricSource{
+			MetricSourceRef: name,
+			Type:            source.Type,
+			Spec:            make(map[string]any, len(source.Spec)),
+		}
+		for key, value := range source.Spec {
+			if _, merged := details[key]; !merged {
+				exported.Spec[key] = value
+			}
+		}
+		*metric.spec = &v1.SLIMetricSpec{MetricSource: exported}
+	}
+	return spec
+}
+
+// findV1MergedDataSource finds the only [v1.DataSource] whose connection details were merged into the source.
+// The name and the decoded connection details of the [v1.DataSource] are returned.
+func (r *ReferenceExporter) findV1MergedDataSource(source v1.SLIMetricSource) (string, map[string]any, bool) {
+	var (
+		name    string
+		details map[string]any
+		found   bool
+	)
+	for _, dataSource := range FilterByType[v1.DataSource](r.objects) {
+		if dataSource.Spec.Type != source.Type {
+			continue
+		}
+		var decoded map[string]any
+		if err := json.Unmarshal(dataSource.Spec.ConnectionDetails, &decoded); err != nil || len(decoded) == 0 {
+			continue
+		}
+		if !isMapSubset(decoded, source.Spec) {
+			continue
+		}
+		if found {
+			return "", nil, false
+		}
+		name, details, found = dataSource.Metadata.Name, decoded, true
+	}
+	return name, details, found
+}
+
+// isMapSubset returns true if all the key-value pairs of subset are present in m.
+func isMapSubset(subset, m map[string]any) bool {
+	for key, value := range subset {
+		if v, ok := m[key]; !ok || !reflect.DeepEqual(v, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *ReferenceExporter) exportV2alphaObject(object openslo.Object) []openslo.Object {
+	switch v := object.(type) {
+	case v2alpha.AlertPolicy:
+		return r.exportV2alphaAlertPolicy(v)
+	case v2alpha.SLO:
+		return r.exportV2alphaSLO(v)
+	case v2alpha.SLI:
+		return r.exportV2alphaSLI(v)
+	default:
+		return []openslo.Object{object}
+	}
+}
+
+func (r *ReferenceExporter) exportV2alphaAlertPolicy(alertPolicy v2alpha.AlertPolicy) []openslo.Object {
+	exported := make([]openslo.Object, 0)
+	if r.config.V2alpha.AlertPolicy.AlertNotificationTarget {
+		exported = append(exported, r.exportV2alphaAlertPolicyTargets(&alertPolicy)...)
+	}
+	if r.config.V2alpha.AlertPolicy.AlertCondition {
+		exported = append(exported, r.exportV2alphaAlertPolicyConditions(&alertPolicy)...)
+	}
+	return append([]openslo.Object{alertPolicy}, exported...)
+}
+
+func (r *ReferenceExporter) exportV2alphaAlertPolicyTargets(alertPolicy *v2alpha.AlertPolicy) []openslo.Object {
+	exported := make([]openslo.Object, 0)
+	for i, target := range alertPolicy.Spec.NotificationTargets {
+		if target.AlertPolicyNotificationTargetInline == nil {
+			continue
+		}
+		exported = append(exported, v2alpha.NewAlertNotificationTarget(target.Metadata, target.Spec))
+		target.AlertPolicyNotificationTargetRef = &v2alpha.AlertPolicyNotificationTargetRef{
+			TargetRef: target.Metadata.Name,
+		}
+		target.AlertPolicyNotificationTargetInline = nil
+		alertPolicy.Spec.NotificationTargets[i] = target
+	}
+	return exported
+}
+
+func (r *ReferenceExporter) exportV2alphaAlertPolicyConditions(alertPolicy *v2alpha.AlertPolicy) []openslo.Object {
+	exported := make([]openslo.Object, 0)
+	for i, condition := range alertPolicy.Spec.Conditions {
+		if condition.AlertPolicyConditionInline == nil {
+			continue
+		}
+		exported = append(exported, v2alpha.NewAlertCondition(condition.Metadata, condition.Spec))
+		condition.AlertPolicyConditionRef = &v2alpha.AlertPolicyConditionRef{
+			ConditionRef: condition.Metadata.Name,
+		}
+		condition.AlertPolicyConditionInline = nil
+		alertPolicy.Spec.Conditions[i] = condition
+	}
+	return exported
+}
+
+func (r *ReferenceExporter) exportV2alphaSLO(slo v2alpha.SLO) []openslo.Object {
+	exported := make([]openslo.Object, 0)
+	if r.config.V2alpha.SLO.AlertPolicy {
+		exported = append(exported, r.exportV2alphaSLOAlertPolicies(&slo)...)
+	}
+	if r.config.V2alpha.SLO.SLI {
+		exported = append(exported, r.exportV2alphaSLOSLI(&slo)...)
+	}
+	if r.config.V2alpha.SLI.DataSource {
+		exported = append(exported, r.exportV2alphaSLODataSources(&slo)...)
+	}
+	return append([]openslo.Object{slo}, exported...)
+}
+
+func (r *ReferenceExporter) exportV2alphaSLOAlertPolicies(slo *v2alpha.SLO) []openslo.Object {
+	exported := make([]openslo.Object, 0)
+	for i, ap := range slo.Spec.AlertPolicies {
+		if ap.SLOAlertPolicyInline == nil {
+			continue
+		}
+		alertPolicy := v2alpha.NewAlertPolicy(ap.Metadata, ap.Spec)
+		exported = append(exported, r.exportV2alphaAlertPolicy(alertPolicy)...)
+		ap.SLOAlertPolicyRef = &v2alpha.SLOAlertPolicyRef{
+			AlertPolicyRef: alertPolicy.Metadata.Name,
+		}
+		ap.SLOAlertPolicyInline = nil
+		slo.Spec.AlertPolicies[i] = ap
+	}
+	return exported
+}
+
+func (r *ReferenceExporter) exportV2alphaSLOSLI(slo *v2alpha.SLO) []openslo.Object {
+	exported := make([]openslo.Object, 0)
+	if slo.Spec.SLI != nil {
+		exported = append(exported, r.exportV2alphaSLI(v2alpha.NewSLI(slo.Spec.SLI.Metadata, slo.Spec.SLI.Spec))...)
+		slo.Spec.SLIRef = &slo.Spec.SLI.Metadata.Name
+		slo.Spec.SLI = nil
+	}
+	for i, objective := range slo.Spec.Objectives {
+		if objective.SLI == nil {
+			continue
+		}
+		exported = append(exported, r.exportV2alphaSLI(v2alpha.NewSLI(objective.SLI.Metadata, objective.SLI.Spec))...)
+		objective.SLIRef = &objective.SLI.Metadata.Name
+		objective.SLI = nil
+		slo.Spec.Objectives[i] = objective
+	}
+	return exported
+}
+
+func (r *ReferenceExporter) exportV2alphaSLODataSources(slo *v2alpha.SLO) []openslo.Object {
+	exported := make([]openslo.Object, 0)
+	if slo.Spec.SLI != nil {
+		var dataSources []openslo.Object
+		slo.Spec.SLI, dataSources = r.exportV2alphaSLOSLIDataSources(slo.Spec.SLI)
+		exported = append(exported, dataSources...)
+	}
+	for i, objective := range slo.Spec.Objectives {
+		if objective.SLI == nil {
+			continue
+		}
+		var dataSources []openslo.Object
+		objective.SLI, dataSources = r.exportV2alphaSLOSLIDataSources(objective.SLI)
+		exported = append(exported, dataSources...)
+		slo.Spec.Objectives[i] = objective
+	}
+	return exported
+}
+
+func (r *ReferenceExporter) exportV2alphaSLOSLIDataSources(
+	sli *v2alpha.SLOSLIInline,
+) (*v2alpha.SLOSLIInline, []openslo.Object) {
+	spec, exported := r.exportV2alphaSLISpecDataSources(sli.Metadata.Name, sli.Spec)
+	return &v2alpha.SLOSLIInline{
+		Metadata: sli.Metadata,
+		Spec:     spec,
+	}, exported
+}
+
+func (r *ReferenceExporter) exportV2alphaSLI(sli v2alpha.SLI) []openslo.Object {
+	if !r.config.V2alpha.SLI.DataSource {
+		return []openslo.Object{sli}
+	}
+	var exported []openslo.Object
+	sli.Spec, exported = r.exportV2alphaSLISpecDataSources(sli.Metadata.Name, sli.Spec)
+	return append([]openslo.Object{sli}, exported...)
+}
+
+// exportV2alphaSLISpecDataSources exports every [v2alpha.DataSourceSpec] inlined in the metrics of [v2alpha.SLISpec].
+// If exactly one [v2alpha.DataSource] provided to the [ReferenceExporter] has the same spec,
+// the metric references it and no new object is exported.
+// Otherwise, since [v2alpha.DataSourceSpec] does not carry a name, the exported [v2alpha.DataSource]
+// is named after the SLI and the metric it was defined for, e.g. 'my-sli-good'.
+// If multiple metrics define the same [v2alpha.DataSourceSpec], only a single [v2alpha.DataSource] is exported.
+func (r *ReferenceExporter) exportV2alphaSLISpecDataSources(
+	sliName string,
+	spec v2alpha.SLISpec,
+) (v2alpha.SLISpec, []openslo.Object) {
+	dataSources := make([]v2alpha.DataSource, 0)
+	for _, metric := range getV2alphaSLIMetricSpecs(&spec) {
+		metricSpec := *metric.spec
+		if metricSpec.DataSourceSpec == nil {
+			continue
+		}
+		if name, ok := r.findV2alphaDataSource(*metricSpec.DataSourceSpec); ok {
+			*metric.spec = &v2alpha.SLIMetricSpec{
+				DataSourceRef: name,
+				Spec:          metricSpec.Spec,
+			}
+			continue
+		}
+		var name string
+		idx := slices.IndexFunc(dataSources, func(d v2alpha.DataSource) bool {
+			return reflect.DeepEqual(d.Spec, *metricSpec.DataSourceSpec)
+		})
+		if idx == -1 {
+			name = sliName + "-" + metric.name
+			dataSources = append(dataSources, v2alpha.NewDataSource(
+				v2alpha.Metadata{Name: name},
+				*metricSpec.DataSourceSpec,
+			))
+		} else {
+			name = dataSources[idx].Metadata.Name
+		}
+		*metric.spec = &v2alpha.SLIMetricSpec{
+			DataSourceRef: name,
+			Spec:          metricSpec.Spec,
+		}
+	}
+	exported := make([]openslo.Object, 0, len(dataSources))
+	for _, dataSource := range dataSources {
+		exported = append(exported, dataSource)
+	}
+	return spec, exported
+}
+
+// findV2alphaDataSource finds the only [v2alpha.DataSource] with a spec equal to the provided one.
+// Connection details are compared by their decoded value, regardless of the JSON formatting.
+func (r *ReferenceExporter) findV2alphaDataSource(spec v2alpha.DataSourceSpec) (string, bool) {
+	var (
+		name  string
+		found bool
+	)
+	for _, dataSource := range FilterByType[v2alpha.DataSource](r.objects) {
+		if dataSource.Spec.Type != spec.Type ||
+			dataSource.Spec.Description != spec.Description ||
+			!isJSONEqual(dataSource.Spec.ConnectionDetails, spec.ConnectionDetails) {
+			continue
+		}
+		if found {
+			return "", false
+		}
+		name, found = dataSource.Metadata.Name, true
+	}
+	return name, found
+}
+
+// isJSONEqual returns true if both JSON documents decode to the same value.
+func isJSONEqual(a, b json.RawMessage) bool {
+	var decodedA, decodedB any
+	if err := json.Unmarshal(a, &decodedA); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &decodedB); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(decodedA, decodedB)
+}
+
 func (r *ReferenceExporter) addResult(objects ...openslo.Object) {
 	r.exported = append(r.exported, objects...)
 }
@@ -24,6 +24,24 @@ func TestReferenceExporter_Export(t *testing.T) {
 		"v1: SLO": {
 			filename: "v1_slo.yaml",
 		},
+		"v1: composite SLO": {
+			filename: "v1_composite_slo.yaml",
+		},
+		"v1: DataSources": {
+			filename: "v1_sli_data_sources.yaml",
+		},
+		"v2alpha: Alert Policies": {
+			filename: "v2alpha_alert_policies.yaml",
+		},
+		"v2alpha: SLO": {
+			filename: "v2alpha_slo.yaml",
+		},
+		"v2alpha: DataSources": {
+			filename: "v2alpha_sli_data_sources.yaml",
+		},
+		"v2alpha: composite SLO": {
+			filename: "v2alpha_composite_slo.yaml",
+		},
 		"custom config, do not resolve anything": {
 			filename: "custom_config.yaml",
 			exporterMod: func(r *ReferenceExporter) *ReferenceExporter {
@@ -70,3 +88,18 @@ func TestReferenceExporter_Export(t *testing.T) {
 		})
 	}
 }
+
+func TestReferenceExporter_Export_RoundTrip(t *testing.T) {
+	input := readTestData(t, testData, "export/round_trip/v2alpha_sli_data_sources.yaml")
+	objects, err := Decode(bytes.NewReader(input), FormatYAML)
+	assert.Require(t, assert.NoError(t, err))
+
+	inlined, err := NewReferenceInliner(objects...).Inline()
+	assert.Require(t, assert.NoError(t, err))
+	exported := NewReferenceExporter(inlined...).Export()
+
+	var buf bytes.Buffer
+	err = Encode(&buf, FormatYAML, exported...)
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, string(input), buf.String())
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/OpenSLO/go-sdk/pkg/openslo"
 	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
 )
 
 func NewReferenceInliner(objects ...openslo.Object) *ReferenceInliner {
@@ -33,6 +34,7 @@ type ReferenceInliner struct {
 // Inline finds all referenced objects in the provided slice of [openslo.Object]
 // and replaces the references with an inlined version of the referenced [openslo.Object].
 // If the referenced object is not found in the provided [openslo.Object] slice, an error will be returned.
+// The same applies to ambiguous references, which match more than one object of the same version, kind and name.
 //
 // By default, it will not remove referenced objects from the result.
 // If you want to remove referenced objects, you can use the [ReferenceInliner.RemoveReferencedObjects] option.
@@ -91,6 +93,12 @@ func (r *ReferenceInliner) inlineObject(object openslo.Object) error {
 			return fmt.Errorf("failed to inline %s: %w", object, err)
 		}
 		r.addResult(inlinedObject)
+	case openslo.VersionV2alpha:
+		inlinedObject, err := r.inlineV2alphaObject(object)
+		if err != nil {
+			return fmt.Errorf("failed to inline %s: %w", object, err)
+		}
+		r.addResult(inlinedObject)
 	default:
 		r.addResult(object)
 	}
@@ -111,9 +119,11 @@ func (r *ReferenceInliner) removeReferencedObjects() []openslo.Object {
 func (r *ReferenceInliner) inlineV1Object(object openslo.Object) (openslo.Object, error) {
 	switch v := object.(type) {
 	case v1.AlertPolicy:
-		return r.inlineV1AlertPolicy(v)
+		return r.inlineV1AlertPolicy(v.DeepCopy())
 	case v1.SLO:
-		return r.inlineV1SLO(v)
+		return r.inlineV1SLO(v.DeepCopy())
+	case v1.SLI:
+		return r.inlineV1SLI(v.DeepCopy())
 	default:
 		return object, nil
 	}
@@ -141,13 +151,13 @@ func (r *ReferenceInliner) inlineV1AlertPolicyTargets(alertPolicy v1.AlertPolicy
 		if target.AlertPolicyNotificationTargetRef == nil {
 			continue
 		}
-		alertNotificationTarget, idx := findObject[v1.AlertNotificationTarget](r.references, target.TargetRef)
-		if idx == -1 {
-			return v1.AlertPolicy{}, newReferenceNotFoundErr(
-				alertNotificationTarget,
-				fmt.Sprintf("spec.notificationTargets[%d].targetRef", i),
-				target.TargetRef,
-			)
+		alertNotificationTarget, idx, err := findObject[v1.AlertNotificationTarget](
+			r.references,
+			target.TargetRef,
+			fmt.Sprintf("spec.notificationTargets[%d].targetRef", i),
+		)
+		if err != nil {
+			return v1.AlertPolicy{}, err
 		}
 		target.AlertPolicyNotificationTargetRef = nil
 		target.AlertPolicyNotificationTargetInline = &v1.AlertPolicyNotificationTargetInline{
@@ -166,13 +176,13 @@ func (r *ReferenceInliner) inlineV1AlertPolicyConditions(alertPolicy v1.AlertPol
 		if condition.AlertPolicyConditionRef == nil {
 			continue
 		}
-		alertCondition, idx := findObject[v1.AlertCondition](r.references, condition.ConditionRef)
-		if idx == -1 {
-			return v1.AlertPolicy{}, newReferenceNotFoundErr(
-				alertCondition,
-				fmt.Sprintf("spec.conditions[%d].conditionRef", i),
-				condition.ConditionRef,
-			)
+		alertCondition, idx, err := findObject[v1.AlertCondition](
+			r.references,
+			condition.ConditionRef,
+			fmt.Sprintf("spec.conditions[%d].conditionRef", i),
+		)
+		if err != nil {
+			return v1.AlertPolicy{}, err
 		}
 		condition.AlertPolicyConditionRef = nil
 		condition.AlertPolicyConditionInline = &v1.AlertPolicyConditionInline{
@@ -200,6 +210,12 @@ func (r *ReferenceInliner) inlineV1SLO(slo v1.SLO) (v1.SLO, error) {
 			return v1.SLO{}, err
 		}
 	}
+	if r.config.V1.SLI.DataSource {
+		slo, err = r.inlineV1SLODataSources(slo)
+		if err != nil {
+			return v1.SLO{}, err
+		}
+	}
 	return slo, nil
 }
 
@@ -210,21 +226,24 @@ func (r *ReferenceInliner) inlineV1SLOAlertPolicies(slo v1.SLO) (v1.SLO, error)
 		case ap.SLOAlertPolicyInline != nil:
 			alertPolicy = v1.NewAlertPolicy(ap.Metadata, ap.Spec)
 		default:
-			var idx int
-			alertPolicy, idx = findObject[v1.AlertPolicy](r.references, ap.AlertPolicyRef)
-			if idx == -1 {
-				return v1.SLO{}, newReferenceNotFoundErr(
-					alertPolicy,
-					fmt.Sprintf("spec.alertPolicies[%d].alertPolicyRef", i),
-					ap.AlertPolicyRef,
-				)
+			var (
+				idx int
+				err error
+			)
+			alertPolicy, idx, err = findObject[v1.AlertPolicy](
+				r.references,
+				ap.AlertPolicyRef,
+				fmt.Sprintf("spec.alertPolicies[%d].alertPolicyRef", i),
+			)
+			if err != nil {
+				return v1.SLO{}, err
 			}
 			r.referencedObjectIndexes[idx] = true
 		}
 
 		inlinedAlertPolicy, err := r.inlineV1AlertPolicy(alertPolicy)
 		if err != nil {
-			var refErr referenceNotFoundErr
+			var refErr referenceErr
 			if errors.As(err, &refErr) {
 				refErr.fieldPath = fmt.Sprintf("spec.alertPolicies[%d].%s", i, refErr.fieldPath)
 				return v1.SLO{}, refErr
@@ -245,56 +264,475 @@ func (r *ReferenceInliner) inlineV1SLOAlertPolicies(slo v1.SLO) (v1.SLO, error)
 }
 
 func (r *ReferenceInliner) inlineV1SLOSLI(slo v1.SLO) (v1.SLO, error) {
-	if slo.Spec.IndicatorRef == nil {
-		return slo, nil
-	}
-	sli, idx := findObject[v1.SLI](r.references, *slo.Spec.IndicatorRef)
-	if idx == -1 {
-		return v1.SLO{}, newReferenceNotFoundErr(
-			sli,
-			"spec.indicatorRef",
-			*slo.Spec.IndicatorRef,
-		)
+	if slo.Spec.IndicatorRef != nil {
+		indicator, err := r.findV1SLI(*slo.Spec.IndicatorRef, "spec.indicatorRef")
+		if err != nil {
+			return v1.SLO{}, err
+		}
+		slo.Spec.IndicatorRef = nil
+		slo.Spec.Indicator = indicator
+	}
+	for i, objective := range slo.Spec.Objectives {
+		if objective.IndicatorRef == nil {
+			continue
+		}
+		indicator, err := r.findV1SLI(*objective.IndicatorRef, fmt.Sprintf("spec.objectives[%d].indicatorRef", i))
+		if err != nil {
+			return v1.SLO{}, err
+		}
+		objective.IndicatorRef = nil
+		objective.Indicator = indicator
+		slo.Spec.Objectives[i] = objective
 	}
-	slo.Spec.IndicatorRef = nil
-	slo.Spec.Indicator = &v1.SLOIndicatorInline{
+	return slo, nil
+}
+
+func (r *ReferenceInliner) findV1SLI(name, path string) (*v1.SLOIndicatorInline, error) {
+	sli, idx, err := findObject[v1.SLI](r.references, name, path)
+	if err != nil {
+		return nil, err
+	}
+	r.referencedObjectIndexes[idx] = true
+	return &v1.SLOIndicatorInline{
 		Metadata: sli.Metadata,
 		Spec:     sli.Spec,
+	}, nil
+}
+
+func (r *ReferenceInliner) inlineV1SLODataSources(slo v1.SLO) (v1.SLO, error) {
+	if slo.Spec.Indicator != nil {
+		indicator, err := r.inlineV1SLOIndicatorDataSources(slo.Spec.Indicator, "spec.indicator")
+		if err != nil {
+			return v1.SLO{}, err
+		}
+		slo.Spec.Indicator = indicator
+	}
+	for i, objective := range slo.Spec.Objectives {
+		if objective.Indicator == nil {
+			continue
+		}
+		indicator, err := r.inlineV1SLOIndicatorDataSources(
+			objective.Indicator,
+			fmt.Sprintf("spec.objectives[%d].indicator", i),
+		)
+		if err != nil {
+			return v1.SLO{}, err
+		}
+		objective.Indicator = indicator
+		slo.Spec.Objectives[i] = objective
+	}
+	return slo, nil
+}
+
+func (r *ReferenceInliner) inlineV1SLOIndicatorDataSources(
+	indicator *v1.SLOIndicatorInline,
+	path string,
+) (*v1.SLOIndicatorInline, error) {
+	spec, err := r.inlineV1SLISpecDataSources(indicator.Spec)
+	if err != nil {
+		return nil, prefixReferenceErr(err, path)
+	}
+	return &v1.SLOIndicatorInline{
+		Metadata: indicator.Metadata,
+		Spec:     spec,
+	}, nil
+}
+
+func (r *ReferenceInliner) inlineV1SLI(sli v1.SLI) (v1.SLI, error) {
+	if !r.config.V1.SLI.DataSource {
+		return sli, nil
+	}
+	spec, err := r.inlineV1SLISpecDataSources(sli.Spec)
+	if err != nil {
+		return v1.SLI{}, err
+	}
+	sli.Spec = spec
+	return sli, nil
+}
+
+func (r *ReferenceInliner) inlineV1SLISpecDataSources(spec v1.SLISpec) (v1.SLISpec, error) {
+	for _, metric := range getV1SLIMetricSpecs(&spec) {
+		inlined, err := r.inlineV1SLIMetricSpecDataSource(*metric.spec, metric.path)
+		if err != nil {
+			return v1.SLISpec{}, err
+		}
+		*metric.spec = inlined
+	}
+	return spec, nil
+}
+
+func (r *ReferenceInliner) inlineV1SLIMetricSpecDataSource(
+	metricSpec *v1.SLIMetricSpec,
+	path string,
+) (*v1.SLIMetricSpec, error) {
+	ref := metricSpec.MetricSource.MetricSourceRef
+	if ref == "" {
+		return metricSpec, nil
+	}
+	dataSource, idx, err := findObject[v1.DataSource](r.references, ref, path+".metricSource.metricSourceRef")
+	if err != nil {
+		return nil, err
+	}
+	if !r.config.V1.SLI.MergeConnectionDetails {
+		inlined := *metricSpec
+		inlined.MetricSource.Type = dataSource.Spec.Type
+		return &inlined, nil
+	}
+	spec, err := mergeConnectionDetails(metricSpec.MetricSource.Spec, dataSource.Spec.ConnectionDetails)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inline %s referenced at '%s.metricSource.metricSourceRef': %w",
+			dataSource, path, err)
 	}
 	r.referencedObjectIndexes[idx] = true
+	return &v1.SLIMetricSpec{
+		MetricSource: v1.SLIMetricSource{
+			Type: dataSource.Spec.Type,
+			Spec: spec,
+		},
+	}, nil
+}
+
+func (r *ReferenceInliner) inlineV2alphaObject(object openslo.Object) (openslo.Object, error) {
+	switch v := object.(type) {
+	case v2alpha.AlertPolicy:
+		return r.inlineV2alphaAlertPolicy(v.DeepCopy())
+	case v2alpha.SLO:
+		return r.inlineV2alphaSLO(v.DeepCopy())
+	case v2alpha.SLI:
+		return r.inlineV2alphaSLI(v.DeepCopy())
+	default:
+		return object, nil
+	}
+}
+
+func (r *ReferenceInliner) inlineV2alphaAlertPolicy(alertPolicy v2alpha.AlertPolicy) (v2alpha.AlertPolicy, error) {
+	var err error
+	if r.config.V2alpha.AlertPolicy.AlertNotificationTarget {
+		alertPolicy, err = r.inlineV2alphaAlertPolicyTargets(alertPolicy)
+		if err != nil {
+			return v2alpha.AlertPolicy{}, err
+		}
+	}
+	if r.config.V2alpha.AlertPolicy.AlertCondition {
+		alertPolicy, err = r.inlineV2alphaAlertPolicyConditions(alertPolicy)
+		if err != nil {
+			return v2alpha.AlertPolicy{}, err
+		}
+	}
+	return alertPolicy, nil
+}
+
+func (r *ReferenceInliner) inlineV2alphaAlertPolicyTargets(
+	alertPolicy v2alpha.AlertPolicy,
+) (v2alpha.AlertPolicy, error) {
+	for i, target := range alertPolicy.Spec.NotificationTargets {
+		if target.AlertPolicyNotificationTargetRef == nil {
+			continue
+		}
+		alertNotificationTarget, idx, err := findObject[v2alpha.AlertNotificationTarget](
+			r.references,
+			target.TargetRef,
+			fmt.Sprintf("spec.notificationTargets[%d].targetRef", i),
+		)
+		if err != nil {
+			return v2alpha.AlertPolicy{}, err
+		}
+		target.AlertPolicyNotificationTargetRef = nil
+		target.AlertPolicyNotificationTargetInline = &v2alpha.AlertPolicyNotificationTargetInline{
+			Kind:     alertNotificationTarget.GetKind(),
+			Metadata: alertNotificationTarget.Metadata,
+			Spec:     alertNotificationTarget.Spec,
+		}
+		r.referencedObjectIndexes[idx] = true
+		alertPolicy.Spec.NotificationTargets[i] = target
+	}
+	return alertPolicy, nil
+}
+
+func (r *ReferenceInliner) inlineV2alphaAlertPolicyConditions(
+	alertPolicy v2alpha.AlertPolicy,
+) (v2alpha.AlertPolicy, error) {
+	for i, condition := range alertPolicy.Spec.Conditions {
+		if condition.AlertPolicyConditionRef == nil {
+			continue
+		}
+		alertCondition, idx, err := findObject[v2alpha.AlertCondition](
+			r.references,
+			condition.ConditionRef,
+			fmt.Sprintf("spec.conditions[%d].conditionRef", i),
+		)
+		if err != nil {
+			return v2alpha.AlertPolicy{}, err
+		}
+		condition.AlertPolicyConditionRef = nil
+		condition.AlertPolicyConditionInline = &v2alpha.AlertPolicyConditionInline{
+			Kind:     alertCondition.GetKind(),
+			Metadata: alertCondition.Metadata,
+			Spec:     alertCondition.Spec,
+		}
+		r.referencedObjectIndexes[idx] = true
+		alertPolicy.Spec.Conditions[i] = condition
+	}
+	return alertPolicy, nil
+}
+
+func (r *ReferenceInliner) inlineV2alphaSLO(slo v2alpha.SLO) (v2alpha.SLO, error) {
+	var err error
+	if r.config.V2alpha.SLO.AlertPolicy {
+		slo, err = r.inlineV2alphaSLOAlertPolicies(slo)
+		if err != nil {
+			return v2alpha.SLO{}, err
+		}
+	}
+	if r.config.V2alpha.SLO.SLI {
+		slo, err = r.inlineV2alphaSLOSLI(slo)
+		if err != nil {
+			return v2alpha.SLO{}, err
+		}
+	}
+	if r.config.V2alpha.SLI.DataSource {
+		slo, err = r.inlineV2alphaSLODataSources(slo)
+		if err != nil {
+			return v2alpha.SLO{}, err
+		}
+	}
+	return slo, nil
+}
+
+func (r *ReferenceInliner) inlineV2alphaSLOAlertPolicies(slo v2alpha.SLO) (v2alpha.SLO, error) {
+	for i, ap := range slo.Spec.AlertPolicies {
+		var alertPolicy v2alpha.AlertPolicy
+		switch {
+		case ap.SLOAlertPolicyInline != nil:
+			alertPolicy = v2alpha.NewAlertPolicy(ap.Metadata, ap.Spec)
+		default:
+			var (
+				idx int
+				err error
+			)
+			alertPolicy, idx, err = findObject[v2alpha.AlertPolicy](
+				r.references,
+				ap.AlertPolicyRef,
+				fmt.Sprintf("spec.alertPolicies[%d].alertPolicyRef", i),
+			)
+			if err != nil {
+				return v2alpha.SLO{}, err
+			}
+			r.referencedObjectIndexes[idx] = true
+		}
+
+		inlinedAlertPolicy, err := r.inlineV2alphaAlertPolicy(alertPolicy)
+		if err != nil {
+			var refErr referenceErr
+			if errors.As(err, &refErr) {
+				refErr.fieldPath = fmt.Sprintf("spec.alertPolicies[%d].%s", i, refErr.fieldPath)
+				return v2alpha.SLO{}, refErr
+			}
+			return v2alpha.SLO{}, fmt.Errorf(
+				"failed to inline %s referenced at 'spec.alertPolicies[%d].alertPolicyRef': %w",
+				alertPolicy, i, err)
+		}
+		ap.SLOAlertPolicyRef = nil
+		ap.SLOAlertPolicyInline = &v2alpha.SLOAlertPolicyInline{
+			Kind:     inlinedAlertPolicy.GetKind(),
+			Metadata: inlinedAlertPolicy.Metadata,
+			Spec:     inlinedAlertPolicy.Spec,
+		}
+		slo.Spec.AlertPolicies[i] = ap
+	}
 	return slo, nil
 }
 
+func (r *ReferenceInliner) inlineV2alphaSLOSLI(slo v2alpha.SLO) (v2alpha.SLO, error) {
+	if slo.Spec.SLIRef != nil {
+		sli, err := r.findV2alphaSLI(*slo.Spec.SLIRef, "spec.sliRef")
+		if err != nil {
+			return v2alpha.SLO{}, err
+		}
+		slo.Spec.SLIRef = nil
+		slo.Spec.SLI = sli
+	}
+	for i, objective := range slo.Spec.Objectives {
+		if objective.SLIRef == nil {
+			continue
+		}
+		sli, err := r.findV2alphaSLI(*objective.SLIRef, fmt.Sprintf("spec.objectives[%d].sliRef", i))
+		if err != nil {
+			return v2alpha.SLO{}, err
+		}
+		objective.SLIRef = nil
+		objective.SLI = sli
+		slo.Spec.Objectives[i] = objective
+	}
+	return slo, nil
+}
+
+func (r *ReferenceInliner) findV2alphaSLI(name, path string) (*v2alpha.SLOSLIInline, error) {
+	sli, idx, err := findObject[v2alpha.SLI](r.references, name, path)
+	if err != nil {
+		return nil, err
+	}
+	r.referencedObjectIndexes[idx] = true
+	return &v2alpha.SLOSLIInline{
+		Metadata: sli.Metadata,
+		Spec:     sli.Spec,
+	}, nil
+}
+
+func (r *ReferenceInliner) inlineV2alphaSLODataSources(slo v2alpha.SLO) (v2alpha.SLO, error) {
+	if slo.Spec.SLI != nil {
+		sli, err := r.inlineV2alphaSLOSLIDataSources(slo.Spec.SLI, "spec.sli")
+		if err != nil {
+			return v2alpha.SLO{}, err
+		}
+		slo.Spec.SLI = sli
+	}
+	for i, objective := range slo.Spec.Objectives {
+		if objective.SLI == nil {
+			continue
+		}
+		sli, err := r.inlineV2alphaSLOSLIDataSources(objective.SLI, fmt.Sprintf("spec.objectives[%d].sli", i))
+		if err != nil {
+			return v2alpha.SLO{}, err
+		}
+		objective.SLI = sli
+		slo.Spec.Objectives[i] = objective
+	}
+	return slo, nil
+}
+
+func (r *ReferenceInliner) inlineV2alphaSLOSLIDataSources(
+	sli *v2alpha.SLOSLIInline,
+	path string,
+) (*v2alpha.SLOSLIInline, error) {
+	spec, err := r.inlineV2alphaSLISpecDataSources(sli.Spec)
+	if err != nil {
+		return nil, prefixReferenceErr(err, path)
+	}
+	return &v2alpha.SLOSLIInline{
+		Metadata: sli.Metadata,
+		Spec:     spec,
+	}, nil
+}
+
+func (r *ReferenceInliner) inlineV2alphaSLI(sli v2alpha.SLI) (v2alpha.SLI, error) {
+	if !r.config.V2alpha.SLI.DataSource {
+		return sli, nil
+	}
+	spec, err := r.inlineV2alphaSLISpecDataSources(sli.Spec)
+	if err != nil {
+		return v2alpha.SLI{}, err
+	}
+	sli.Spec = spec
+	return sli, nil
+}
+
+func (r *ReferenceInliner) inlineV2alphaSLISpecDataSources(spec v2alpha.SLISpec) (v2alpha.SLISpec, error) {
+	for _, metric := range getV2alphaSLIMetricSpecs(&spec) {
+		metricSpec := *metric.spec
+		if metricSpec.DataSourceRef == "" {
+			continue
+		}
+		dataSource, idx, err := findObject[v2alpha.DataSource](
+			r.references,
+			metricSpec.DataSourceRef,
+			metric.path+".dataSourceRef",
+		)
+		if err != nil {
+			return v2alpha.SLISpec{}, err
+		}
+		r.referencedObjectIndexes[idx] = true
+		dataSourceSpec := dataSource.Spec
+		*metric.spec = &v2alpha.SLIMetricSpec{
+			DataSourceSpec: &dataSourceSpec,
+			Spec:           metricSpec.Spec,
+		}
+	}
+	return spec, nil
+}
+
 func (r *ReferenceInliner) addResult(object openslo.Object) {
 	r.inlined = append(r.inlined, object)
 }
 
-func findObject[T openslo.Object](objects []openslo.Object, name string) (object T, objectIndex int) {
+// findObject finds the [openslo.Object] of type T with the provided name.
+// If no such object exists or if the name matches more than one object,
+// [referenceErr] with the provided field path is returned.
+// A deep copy of the object is returned, so that the inlined objects do not share any data with the referenced ones.
+func findObject[T interface {
+	openslo.Object
+	DeepCopy() T
+}](
+	objects []openslo.Object,
+	name, path string,
+) (object T, objectIndex int, err error) {
+	var indexes []int
 	for i := range objects {
 		if objects[i].GetName() != name {
 			continue
 		}
-		if v, ok := objects[i].(T); ok {
-			return v, i
+		v, ok := objects[i].(T)
+		if !ok {
+			continue
+		}
+		if len(indexes) == 0 {
+			object = v.DeepCopy()
 		}
+		indexes = append(indexes, i)
+	}
+	switch len(indexes) {
+	case 0:
+		return object, -1, newReferenceNotFoundErr(object, path, name)
+	case 1:
+		return object, indexes[0], nil
+	default:
+		var zero T
+		return zero, -1, newAmbiguousReferenceErr(zero, path, name, indexes)
 	}
-	return object, -1
+}
+
+// prefixReferenceErr prepends the provided path to the field path of [referenceErr].
+// Other errors are returned as is.
+func prefixReferenceErr(err error, prefix string) error {
+	var refErr referenceErr
+	if errors.As(err, &refErr) {
+		refErr.fieldPath = prefix + "." + refErr.fieldPath
+		return refErr
+	}
+	return err
 }
 
 func newReferenceNotFoundErr(object openslo.Object, path, name string) error {
-	return referenceNotFoundErr{
+	return referenceErr{
 		objectName: name,
 		fieldPath:  path,
 		object:     object,
 	}
 }
 
-type referenceNotFoundErr struct {
+func newAmbiguousReferenceErr(object openslo.Object, path, name string, indexes []int) error {
+	return referenceErr{
+		objectName: name,
+		fieldPath:  path,
+		object:     object,
+		indexes:    indexes,
+	}
+}
+
+// referenceErr is returned when a reference cannot be resolved,
+// either because the referenced object does not exist or because the reference is ambiguous.
+type referenceErr struct {
 	objectName string
 	fieldPath  string
 	object     openslo.Object
+	// indexes of all the objects matching an ambiguous reference.
+	indexes []int
 }
 
-func (r referenceNotFoundErr) Error() string {
+func (r referenceErr) Error() string {
+	if len(r.indexes) > 0 {
+		return fmt.Sprintf("%s '%s' referenced at '%s' is ambiguous, it matches objects at indexes: %s",
+			r.object, r.objectName, r.fieldPath, joinIndexes(r.indexes))
+	}
 	return fmt.Sprintf("%s '%s' referenced at '%s' does not exist", r.object, r.objectName, r.fieldPath)
 }
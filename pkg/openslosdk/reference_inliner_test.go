@@ -56,6 +56,82 @@ func TestReferenceInliner_Inline(t *testing.T) {
 			err: errors.New("failed to inline v1.SLO 'my-slo': v1.AlertNotificationTarget 'devs-email-notification'" +
 				" referenced at 'spec.alertPolicies[0].spec.notificationTargets[1].targetRef' does not exist"),
 		},
+		"v1: ambiguous SLI for SLO": {
+			filename: "v1_slo_ambiguous_sli.yaml",
+			err: errors.New("failed to inline v1.SLO 'my-slo': v1.SLI 'my-sli' referenced at 'spec.indicatorRef'" +
+				" is ambiguous, it matches objects at indexes: 1, 2"),
+		},
+		"v1: valid DataSources for SLI": {
+			filename: "v1_sli_data_sources.yaml",
+		},
+		"v1: valid DataSources for SLI - merge connection details": {
+			filename: "v1_sli_data_sources_merged.yaml",
+			inlinerMod: func(r *ReferenceInliner) *ReferenceInliner {
+				config := defaultReferenceConfig()
+				config.V1.SLI.MergeConnectionDetails = true
+				return r.WithConfig(config)
+			},
+		},
+		"v1: non-existing DataSource for SLI": {
+			filename: "v1_sli_invalid_data_source.yaml",
+			err: errors.New("failed to inline v1.SLI 'my-sli': v1.DataSource 'no-prometheus'" +
+				" referenced at 'spec.ratioMetric.total.metricSource.metricSourceRef' does not exist"),
+		},
+		"v1: non-existing DataSource for SLI in SLO": {
+			filename: "v1_slo_invalid_data_source.yaml",
+			err: errors.New("failed to inline v1.SLO 'my-slo': v1.DataSource 'my-prometheus'" +
+				" referenced at 'spec.indicator.spec.thresholdMetric.metricSource.metricSourceRef' does not exist"),
+		},
+		"v1: valid composite SLO": {
+			filename:   "v1_composite_slo.yaml",
+			inlinerMod: func(r *ReferenceInliner) *ReferenceInliner { return r.RemoveReferencedObjects() },
+		},
+		"v1: non-existing SLI for composite SLO objective": {
+			filename: "v1_composite_slo_invalid_sli.yaml",
+			err: errors.New("failed to inline v1.SLO 'my-composite-slo':" +
+				" v1.SLI 'no-sli' referenced at 'spec.objectives[2].indicatorRef' does not exist"),
+		},
+		"v2alpha: valid Alert Policies - keep refs": {
+			filename: "v2alpha_alert_policies_keep_refs.yaml",
+		},
+		"v2alpha: ambiguous AlertCondition for Alert Policies": {
+			filename: "v2alpha_alert_policies_ambiguous_condition.yaml",
+			err: errors.New("failed to inline v2alpha.AlertPolicy 'ambiguous-condition': v2alpha.AlertCondition" +
+				" 'cpu-usage-breach' referenced at 'spec.conditions[0].conditionRef'" +
+				" is ambiguous, it matches objects at indexes: 1, 3"),
+		},
+		"v2alpha: valid SLO": {
+			filename:   "v2alpha_slo.yaml",
+			inlinerMod: func(r *ReferenceInliner) *ReferenceInliner { return r.RemoveReferencedObjects() },
+		},
+		"v2alpha: non-existing SLI for SLO": {
+			filename: "v2alpha_slo_invalid_sli.yaml",
+			err: errors.New("failed to inline v2alpha.SLO 'my-slo':" +
+				" v2alpha.SLI 'no-sli' referenced at 'spec.sliRef' does not exist"),
+		},
+		"v2alpha: non-existing AlertNotificationTarget for AlertPolicy in SLO": {
+			filename: "v2alpha_slo_invalid_target.yaml",
+			err: errors.New("failed to inline v2alpha.SLO 'my-slo': v2alpha.AlertNotificationTarget" +
+				" 'devs-email-notification' referenced at" +
+				" 'spec.alertPolicies[0].spec.notificationTargets[1].targetRef' does not exist"),
+		},
+		"v2alpha: valid DataSources for SLI": {
+			filename: "v2alpha_sli_data_sources.yaml",
+		},
+		"v2alpha: non-existing DataSource for SLI": {
+			filename: "v2alpha_sli_invalid_data_source.yaml",
+			err: errors.New("failed to inline v2alpha.SLI 'my-sli': v2alpha.DataSource 'no-prometheus'" +
+				" referenced at 'spec.ratioMetric.total.dataSourceRef' does not exist"),
+		},
+		"v2alpha: valid composite SLO": {
+			filename:   "v2alpha_composite_slo.yaml",
+			inlinerMod: func(r *ReferenceInliner) *ReferenceInliner { return r.RemoveReferencedObjects() },
+		},
+		"v2alpha: non-existing SLI for composite SLO objective": {
+			filename: "v2alpha_composite_slo_invalid_sli.yaml",
+			err: errors.New("failed to inline v2alpha.SLO 'my-composite-slo':" +
+				" v2alpha.SLI 'no-sli' referenced at 'spec.objectives[2].sliRef' does not exist"),
+		},
 		"custom config, do not resolve anything": {
 			filename: "custom_config.yaml",
 			inlinerMod: func(r *ReferenceInliner) *ReferenceInliner {
@@ -110,3 +186,21 @@ func TestReferenceInliner_Inline(t *testing.T) {
 		})
 	}
 }
+
+func TestReferenceInliner_Inline_DoesNotModifyInput(t *testing.T) {
+	for _, filename := range []string{
+		"v1_alert_policies_keep_refs.yaml",
+		"v1_slo.yaml",
+		"v2alpha_slo.yaml",
+	} {
+		t.Run(filename, func(t *testing.T) {
+			path := filepath.Join("inline", "inputs", filename)
+			objects := decodeTestObjects(t, path)
+
+			_, err := NewReferenceInliner(objects...).Inline()
+			assert.Require(t, assert.NoError(t, err))
+
+			assert.Equal(t, decodeTestObjects(t, path), objects)
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package openslosdk
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v1alpha"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+// objectReference describes a reference from one [openslo.Object] to another, named [openslo.Object].
+type objectReference struct {
+	// path is the property path of the reference, relative to the root of the referencing object.
+	path string
+	// object is a zero value of the referenced [openslo.Object].
+	// It's used to determine the version and kind of the referenced object.
+	object openslo.Object
+	// name is the name of the referenced object.
+	name string
+}
+
+// objectLink describes a link from one [openslo.Object] to another [openslo.Object],
+// either through a reference or by inlining the other object.
+type objectLink struct {
+	// path is the property path of the link, relative to the root of the linking object.
+	path string
+	// object is the inlined object converted to its standalone representation,
+	// or a zero value of the referenced object.
+	object openslo.Object
+	// name is the name of the linked object.
+	name string
+	// inline is true if the object is inlined.
+	inline bool
+}
+
+func newInlineLink(path string, object openslo.Object) objectLink {
+	return objectLink{path: path, object: object, name: object.GetName(), inline: true}
+}
+
+// referenceableKinds lists the kinds which can be referenced by other objects, per version.
+var referenceableKinds = map[openslo.Version][]openslo.Kind{
+	openslo.VersionV1alpha: {openslo.KindService},
+	openslo.VersionV1: {
+		openslo.KindService,
+		openslo.KindSLI,
+		openslo.KindDataSource,
+		openslo.KindAlertPolicy,
+		openslo.KindAlertCondition,
+		openslo.KindAlertNotificationTarget,
+	},
+	openslo.VersionV2alpha: {
+		openslo.KindService,
+		openslo.KindSLI,
+		openslo.KindDataSource,
+		openslo.KindAlertPolicy,
+		openslo.KindAlertCondition,
+		openslo.KindAlertNotificationTarget,
+	},
+}
+
+func isReferenceable(version openslo.Version, kind openslo.Kind) bool {
+	return slices.Contains(referenceableKinds[version], kind)
+}
+
+// getObjectReferences returns all the references defined by the [openslo.Object],
+// including the references defined by its inlined objects.
+// Empty references are skipped, it's the responsibility of static validation to report these.
+func getObjectReferences(object openslo.Object) []objectReference {
+	var refs []objectReference
+	for _, link := range getObjectLinks(object) {
+		if !link.inline {
+			refs = append(refs, objectReference{path: link.path, object: link.object, name: link.name})
+			continue
+		}
+		for _, ref := range getObjectReferences(link.object) {
+			ref.path = link.path + "." + ref.path
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// getObjectLinks returns the links defined directly by the [openslo.Object], in the order of their definition.
+// The links of inlined objects are not included, these have to be retrieved from the inlined objects themselves.
+// Empty references are skipped.
+func getObjectLinks(object openslo.Object) []objectLink {
+	var links []objectLink
+	switch v := object.(type) {
+	case v1alpha.SLO:
+		links = getV1alphaSLOLinks(v)
+	case v1.SLO:
+		links = getV1SLOLinks(v)
+	case v1.SLI:
+		links = getV1SLISpecLinks(v.Spec)
+	case v1.AlertPolicy:
+		links = getV1AlertPolicySpecLinks(v.Spec)
+	case v2alpha.SLO:
+		links = getV2alphaSLOLinks(v)
+	case v2alpha.SLI:
+		links = getV2alphaSLISpecLinks(v.Spec)
+	case v2alpha.AlertPolicy:
+		links = getV2alphaAlertPolicySpecLinks(v.Spec)
+	}
+	filtered := links[:0]
+	for _, link := range links {
+		if link.name != "" || link.inline {
+			filtered = append(filtered, link)
+		}
+	}
+	return filtered
+}
+
+func getV1alphaSLOLinks(slo v1alpha.SLO) []objectLink {
+	return []objectLink{
+		{path: "spec.service", object: v1alpha.Service{}, name: slo.Spec.Service},
+	}
+}
+
+func getV1SLOLinks(slo v1.SLO) []objectLink {
+	links := []objectLink{
+		{path: "spec.service", object: v1.Service{}, name: slo.Spec.Service},
+	}
+	if slo.Spec.IndicatorRef != nil {
+		links = append(links, objectLink{
+			path:   "spec.indicatorRef",
+			object: v1.SLI{},
+			name:   *slo.Spec.IndicatorRef,
+		})
+	}
+	if slo.Spec.Indicator != nil {
+		links = append(links, newInlineLink(
+			"spec.indicator",
+			v1.NewSLI(slo.Spec.Indicator.Metadata, slo.Spec.Indicator.Spec),
+		))
+	}
+	for i, objective := range slo.Spec.Objectives {
+		if objective.IndicatorRef != nil {
+			links = append(links, objectLink{
+				path:   fmt.Sprintf("spec.objectives[%d].indicatorRef", i),
+				object: v1.SLI{},
+				name:   *objective.IndicatorRef,
+			})
+		}
+		if objective.Indicator != nil {
+			links = append(links, newInlineLink(
+				fmt.Sprintf("spec.objectives[%d].indicator", i),
+				v1.NewSLI(objective.Indicator.Metadata, objective.Indicator.Spec),
+			))
+		}
+	}
+	for i, alertPolicy := range slo.Spec.AlertPolicies {
+		if alertPolicy.SLOAlertPolicyRef != nil {
+			links = append(links, objectLink{
+				path:   fmt.Sprintf("spec.alertPolicies[%d].alertPolicyRef", i),
+				object: v1.AlertPolicy{},
+				name:   alertPolicy.AlertPolicyRef,
+			})
+		}
+		if alertPolicy.SLOAlertPolicyInline != nil {
+			links = append(links, newInlineLink(
+				fmt.Sprintf("spec.alertPolicies[%d]", i),
+				v1.NewAlertPolicy(alertPolicy.Metadata, alertPolicy.Spec),
+			))
+		}
+	}
+	return links
+}
+
+func getV1SLISpecLinks(spec v1.SLISpec) []objectLink {
+	var links []objectLink
+	for _, metric := range getV1SLIMetricSpecs(&spec) {
+		links = append(links, objectLink{
+			path:   metric.path + ".metricSource.metricSourceRef",
+			object: v1.DataSource{},
+			name:   (*metric.spec).MetricSource.MetricSourceRef,
+		})
+	}
+	return links
+}
+
+func getV1AlertPolicySpecLinks(spec v1.AlertPolicySpec) []objectLink {
+	var links []objectLink
+	for i, condition := range spec.Conditions {
+		if condition.AlertPolicyConditionRef != nil {
+			links = append(links, objectLink{
+				path:   fmt.Sprintf("spec.conditions[%d].conditionRef", i),
+				object: v1.AlertCondition{},
+				name:   condition.ConditionRef,
+			})
+		}
+		if condition.AlertPolicyConditionInline != nil {
+			links = append(links, newInlineLink(
+				fmt.Sprintf("spec.conditions[%d]", i),
+				v1.NewAlertCondition(condition.Metadata, condition.Spec),
+			))
+		}
+	}
+	for i, target := range spec.NotificationTargets {
+		if target.AlertPolicyNotificationTargetRef != nil {
+			links = append(links, objectLink{
+				path:   fmt.Sprintf("spec.notificationTargets[%d].targetRef", i),
+				object: v1.AlertNotificationTarget{},
+				name:   target.TargetRef,
+			})
+		}
+		if target.AlertPolicyNotificationTargetInline != nil {
+			links = append(links, newInlineLink(
+				fmt.Sprintf("spec.notificationTargets[%d]", i),
+				v1.NewAlertNotificationTarget(target.Metadata, target.Spec),
+			))
+		}
+	}
+	return links
+}
+
+func getV2alphaSLOLinks(slo v2alpha.SLO) []objectLink {
+	links := []objectLink{
+		{path: "spec.serviceRef", object: v2alpha.Service{}, name: slo.Spec.ServiceRef},
+	}
+	if slo.Spec.SLIRef != nil {
+		links = append(links, objectLink{
+			path:   "spec.sliRef",
+			object: v2alpha.SLI{},
+			name:   *slo.Spec.SLIRef,
+		})
+	}
+	if slo.Spec.SLI != nil {
+		links = append(links, newInlineLink(
+			"spec.sli",
+			v2alpha.NewSLI(slo.Spec.SLI.Metadata, slo.Spec.SLI.Spec),
+		))
+	}
+	for i, objective := range slo.Spec.Objectives {
+		if objective.SLIRef != nil {
+			links = append(links, objectLink{
+				path:   fmt.Sprintf("spec.objectives[%d].sliRef", i),
+				object: v2alpha.SLI{},
+				name:   *objective.SLIRef,
+			})
+		}
+		if objective.SLI != nil {
+			links = append(links, newInlineLink(
+				fmt.Sprintf("spec.objectives[%d].sli", i),
+				v2alpha.NewSLI(objective.SLI.Metadata, objective.SLI.Spec),
+			))
+		}
+	}
+	for i, alertPolicy := range slo.Spec.AlertPolicies {
+		if alertPolicy.SLOAlertPolicyRef != nil {
+			links = append(links, objectLink{
+				path:   fmt.Sprintf("spec.alertPolicies[%d].alertPolicyRef", i),
+				object: v2alpha.AlertPolicy{},
+				name:   alertPolicy.AlertPolicyRef,
+			})
+		}
+		if alertPolicy.SLOAlertPolicyInline != nil {
+			links = append(links, newInlineLink(
+				fmt.Sprintf("spec.alertPolicies[%d]", i),
+				v2alpha.NewAlertPolicy(alertPolicy.Metadata, alertPolicy.Spec),
+			))
+		}
+	}
+	return links
+}
+
+func getV2alphaSLISpecLinks(spec v2alpha.SLISpec) []objectLink {
+	var links []objectLink
+	for _, metric := range getV2alphaSLIMetricSpecs(&spec) {
+		metricSpec := *metric.spec
+		links = append(links, objectLink{
+			path:   metric.path + ".dataSourceRef",
+			object: v2alpha.DataSource{},
+			name:   metricSpec.DataSourceRef,
+		})
+		if metricSpec.DataSourceSpec != nil {
+			links = append(links, newInlineLink(
+				metric.path+".dataSourceSpec",
+				v2alpha.NewDataSource(v2alpha.Metadata{}, *metricSpec.DataSourceSpec),
+			))
+		}
+	}
+	return links
+}
+
+func getV2alphaAlertPolicySpecLinks(spec v2alpha.AlertPolicySpec) []objectLink {
+	var links []objectLink
+	for i, condition := range spec.Conditions {
+		if condition.AlertPolicyConditionRef != nil {
+			links = append(links, objectLink{
+				path:   fmt.Sprintf("spec.conditions[%d].conditionRef", i),
+				object: v2alpha.AlertCondition{},
+				name:   condition.ConditionRef,
+			})
+		}
+		if condition.AlertPolicyConditionInline != nil {
+			links = append(links, newInlineLink(
+				fmt.Sprintf("spec.conditions[%d]", i),
+				v2alpha.NewAlertCondition(condition.Metadata, condition.Spec),
+			))
+		}
+	}
+	for i, target := range spec.NotificationTargets {
+		if target.AlertPolicyNotificationTargetRef != nil {
+			links = append(links, objectLink{
+				path:   fmt.Sprintf("spec.notificationTargets[%d].targetRef", i),
+				object: v2alpha.AlertNotificationTarget{},
+				name:   target.TargetRef,
+			})
+		}
+		if target.AlertPolicyNotificationTargetInline != nil {
+			links = append(links, newInlineLink(
+				fmt.Sprintf("spec.notificationTargets[%d]", i),
+				v2alpha.NewAlertNotificationTarget(target.Metadata, target.Spec),
+			))
+		}
+	}
+	return links
+}
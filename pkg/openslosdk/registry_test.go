@@ -0,0 +1,119 @@
+package openslosdk
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal"
+	"github.com/OpenSLO/go-sdk/internal/assert"
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+)
+
+const (
+	customVersion    openslo.Version = "acme.com/v1"
+	customKindWidget openslo.Kind    = "Widget"
+	customKindGadget openslo.Kind    = "Gadget"
+)
+
+func init() {
+	openslo.MustRegisterObject(customVersion, customKindWidget, func() openslo.Object {
+		return customObject{Spec: customObjectSpec{Size: 1}}
+	})
+	openslo.MustRegisterObject(customVersion, customKindGadget, func() openslo.Object {
+		return &customObject{}
+	})
+}
+
+type customObject struct {
+	APIVersion openslo.Version `json:"apiVersion"`
+	Kind       openslo.Kind    `json:"kind"`
+	Metadata   struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Spec customObjectSpec `json:"spec"`
+}
+
+type customObjectSpec struct {
+	Size int `json:"size"`
+}
+
+func (c customObject) String() string              { return internal.GetObjectName(c) }
+func (c customObject) GetVersion() openslo.Version { return c.APIVersion }
+func (c customObject) GetKind() openslo.Kind       { return c.Kind }
+func (c customObject) GetName() string             { return c.Metadata.Name }
+
+func (c customObject) Validate() error {
+	if c.Spec.Size < 1 {
+		return errors.New("spec.size must be greater than 0")
+	}
+	return nil
+}
+
+const customObjectsYAML = `
+apiVersion: acme.com/v1
+kind: Widget
+metadata:
+  name: default-size
+spec: {}
+---
+apiVersion: acme.com/v1
+kind: Gadget
+metadata:
+  name: my-gadget
+spec:
+  size: 3
+---
+apiVersion: openslo/v1
+kind: Service
+metadata:
+  name: my-service
+spec: {}
+`
+
+func TestDecode_RegisteredObjects(t *testing.T) {
+	dec := NewDecoder(bytes.NewBufferString(customObjectsYAML), FormatYAML).
+		WithNonOpenSLOPolicy(NonOpenSLOPolicySkip)
+	var objects []openslo.Object
+	for {
+		object, err := dec.Next()
+		if err != nil {
+			assert.Require(t, assert.True(t, errors.Is(err, io.EOF)))
+			break
+		}
+		objects = append(objects, object)
+	}
+
+	assert.Require(t, assert.Len(t, objects, 3))
+	widget, ok := objects[0].(customObject)
+	assert.Require(t, assert.True(t, ok))
+	assert.Equal(t, "v1.Widget 'default-size'", widget.String())
+	assert.Equal(t, 1, widget.Spec.Size)
+	gadget, ok := objects[1].(*customObject)
+	assert.Require(t, assert.True(t, ok))
+	assert.Equal(t, customKindGadget, gadget.GetKind())
+	assert.Equal(t, 3, gadget.Spec.Size)
+	_, ok = objects[2].(v1.Service)
+	assert.True(t, ok)
+
+	assert.NoError(t, Validate(objects...))
+	inlined, err := NewReferenceInliner(objects...).Inline()
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, objects, inlined)
+	assert.Equal(t, objects, NewReferenceExporter(objects...).Export())
+}
+
+func TestDecode_UnregisteredKind(t *testing.T) {
+	_, err := Decode(bytes.NewBufferString(`
+apiVersion: acme.com/v1
+kind: Gizmo
+metadata:
+  name: my-gizmo
+`), FormatYAML)
+	assert.Require(t, assert.Error(t, err))
+	assert.Equal(t,
+		"line 2: failed to decode acme.com/v1 Gizmo: unsupported openslo.Kind: Gizmo for version: acme.com/v1",
+		err.Error())
+}
@@ -0,0 +1,97 @@
+package openslosdk
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+// sliMetricSpecField points to a single, non-nil metric spec field of SLI spec.
+type sliMetricSpecField[T any] struct {
+	// name is a short name of the metric, e.g. 'threshold' or 'good'.
+	name string
+	// path is the property path of the field relative to the SLI object, e.g. 'spec.ratioMetric.good'.
+	path string
+	spec **T
+}
+
+// getV1SLIMetricSpecs returns all metric specs defined for [v1.SLISpec].
+// [v1.SLIRatioMetric] is copied before its fields are collected,
+// this way any modification through the returned fields won't affect the original object.
+func getV1SLIMetricSpecs(spec *v1.SLISpec) []sliMetricSpecField[v1.SLIMetricSpec] {
+	var fields []sliMetricSpecField[v1.SLIMetricSpec]
+	if spec.ThresholdMetric != nil {
+		fields = append(fields, sliMetricSpecField[v1.SLIMetricSpec]{
+			name: "threshold",
+			path: "spec.thresholdMetric",
+			spec: &spec.ThresholdMetric,
+		})
+	}
+	if spec.RatioMetric == nil {
+		return fields
+	}
+	ratio := *spec.RatioMetric
+	spec.RatioMetric = &ratio
+	for _, field := range []sliMetricSpecField[v1.SLIMetricSpec]{
+		{name: "good", spec: &ratio.Good},
+		{name: "bad", spec: &ratio.Bad},
+		{name: "total", spec: &ratio.Total},
+		{name: "raw", spec: &ratio.Raw},
+	} {
+		if *field.spec == nil {
+			continue
+		}
+		field.path = "spec.ratioMetric." + field.name
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// getV2alphaSLIMetricSpecs returns all metric specs defined for [v2alpha.SLISpec].
+// [v2alpha.SLIRatioMetric] is copied before its fields are collected,
+// this way any modification through the returned fields won't affect the original object.
+func getV2alphaSLIMetricSpecs(spec *v2alpha.SLISpec) []sliMetricSpecField[v2alpha.SLIMetricSpec] {
+	var fields []sliMetricSpecField[v2alpha.SLIMetricSpec]
+	if spec.ThresholdMetric != nil {
+		fields = append(fields, sliMetricSpecField[v2alpha.SLIMetricSpec]{
+			name: "threshold",
+			path: "spec.thresholdMetric",
+			spec: &spec.ThresholdMetric,
+		})
+	}
+	if spec.RatioMetric == nil {
+		return fields
+	}
+	ratio := *spec.RatioMetric
+	spec.RatioMetric = &ratio
+	for _, field := range []sliMetricSpecField[v2alpha.SLIMetricSpec]{
+		{name: "good", spec: &ratio.Good},
+		{name: "bad", spec: &ratio.Bad},
+		{name: "total", spec: &ratio.Total},
+		{name: "raw", spec: &ratio.Raw},
+	} {
+		if *field.spec == nil {
+			continue
+		}
+		field.path = "spec.ratioMetric." + field.name
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// mergeConnectionDetails merges data source connection details with metric source spec.
+// Metric source spec fields take precedence over the connection details.
+func mergeConnectionDetails(spec map[string]any, details json.RawMessage) (map[string]any, error) {
+	merged := make(map[string]any, len(spec))
+	if len(details) > 0 {
+		if err := json.Unmarshal(details, &merged); err != nil {
+			return nil, fmt.Errorf("failed to decode connection details: %w", err)
+		}
+	}
+	for k, v := range spec {
+		merged[k] = v
+	}
+	return merged, nil
+}
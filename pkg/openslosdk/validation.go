@@ -1,12 +1,26 @@
 package openslosdk
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/nobl9/govy/pkg/govy"
+	"github.com/nobl9/govy/pkg/jsonpath"
 
 	"github.com/OpenSLO/go-sdk/internal"
 	"github.com/OpenSLO/go-sdk/pkg/openslo"
 )
 
+const (
+	// ErrorCodeReferenceNotFound is reported by [ValidateSet]
+	// when a referenced object is not present in the validated objects set.
+	ErrorCodeReferenceNotFound govy.ErrorCode = "reference_not_found"
+	// ErrorCodeDuplicateObject is reported by [ValidateSet]
+	// when more than one object with the same version, kind and name is present in the validated objects set.
+	ErrorCodeDuplicateObject govy.ErrorCode = "duplicate_object"
+)
+
 func Validate(objects ...openslo.Object) error {
 	errs := make(govy.ValidatorErrors, 0)
 	for i, object := range objects {
@@ -27,3 +41,80 @@ func Validate(objects ...openslo.Object) error {
 	}
 	return errs
 }
+
+// ValidateSet performs validation of the objects as a set.
+// It complements [Validate], which validates every object in isolation.
+//
+// The following rules are enforced:
+//   - Every object must be uniquely identified by its version, kind and name.
+//     Each clashing object is reported with [ErrorCodeDuplicateObject] and the indexes of all the clashing objects.
+//   - Every reference defined by an object, including the ones defined by its inlined objects,
+//     must point to an object of the same version, kind and name which is present in the set.
+//     Each missing reference is reported with [ErrorCodeReferenceNotFound] and the referencing property path.
+func ValidateSet(objects ...openslo.Object) error {
+	indexes := make(map[objectKey][]int, len(objects))
+	for i, object := range objects {
+		key := newObjectKey(object, object.GetName())
+		indexes[key] = append(indexes[key], i)
+	}
+	errs := make(govy.ValidatorErrors, 0)
+	for i, object := range objects {
+		var propErrs govy.PropertyErrors
+		if clashing := indexes[newObjectKey(object, object.GetName())]; len(clashing) > 1 {
+			propErrs = append(propErrs, govy.NewPropertyError(
+				jsonpath.Parse("metadata.name"),
+				object.GetName(),
+				govy.NewRuleError(
+					fmt.Sprintf("%s is defined more than once, at indexes: %s", object, joinIndexes(clashing)),
+					ErrorCodeDuplicateObject,
+				),
+			))
+		}
+		for _, ref := range getObjectReferences(object) {
+			if _, ok := indexes[newObjectKey(ref.object, ref.name)]; ok {
+				continue
+			}
+			propErrs = append(propErrs, govy.NewPropertyError(
+				jsonpath.Parse(ref.path),
+				ref.name,
+				govy.NewRuleError(
+					fmt.Sprintf("%s '%s' does not exist", ref.object, ref.name),
+					ErrorCodeReferenceNotFound,
+				),
+			))
+		}
+		if len(propErrs) == 0 {
+			continue
+		}
+		vErr := govy.NewValidatorError(propErrs).WithName(internal.GetObjectName(object))
+		vErr.SliceIndex = &i
+		errs = append(errs, vErr)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// objectKey uniquely identifies an [openslo.Object] within a set of objects.
+type objectKey struct {
+	version openslo.Version
+	kind    openslo.Kind
+	name    string
+}
+
+func newObjectKey(object openslo.Object, name string) objectKey {
+	return objectKey{
+		version: object.GetVersion(),
+		kind:    object.GetKind(),
+		name:    name,
+	}
+}
+
+func joinIndexes(indexes []int) string {
+	s := make([]string, 0, len(indexes))
+	for _, i := range indexes {
+		s = append(s, strconv.Itoa(i))
+	}
+	return strings.Join(s, ", ")
+}
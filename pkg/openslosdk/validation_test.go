@@ -1,8 +1,13 @@
 package openslosdk
 
 import (
+	"bytes"
+	"errors"
+	"io"
 	"testing"
 
+	"github.com/nobl9/govy/pkg/govy"
+
 	"github.com/OpenSLO/go-sdk/internal/assert"
 	"github.com/OpenSLO/go-sdk/pkg/openslo"
 	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
@@ -49,3 +54,106 @@ Validation for v2alpha.Service 'service2' at index 2 has failed for the followin
     - name part string must match regular expression: '^([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$' (e.g. 'my.domain/MyName', 'MyName', 'my.name', '123-abc'); Kubernetes Qualified Name must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character with an optional DNS subdomain prefix and '/'`
 	assert.Equal(t, expectedError, err.Error())
 }
+
+func TestValidateSet(t *testing.T) {
+	t.Run("valid set", func(t *testing.T) {
+		objects, err := Decode(bytes.NewReader(readTestData(t, testData, "validate_set/valid.yaml")), FormatYAML)
+		assert.Require(t, assert.NoError(t, err))
+		assert.Require(t, assert.NoError(t, Validate(objects...)))
+
+		err = ValidateSet(objects...)
+		assert.NoError(t, err)
+	})
+	t.Run("missing references", func(t *testing.T) {
+		objects, err := Decode(bytes.NewReader(readTestData(t, testData, "validate_set/invalid.yaml")), FormatYAML)
+		assert.Require(t, assert.NoError(t, err))
+		assert.Require(t, assert.NoError(t, Validate(objects...)))
+
+		err = ValidateSet(objects...)
+
+		assert.Require(t, assert.Error(t, err))
+		assert.True(t, govy.HasErrorCode(err, ErrorCodeReferenceNotFound))
+		// nolint: lll
+		expectedError := `Validation for v1alpha.SLO 'web-availability' at index 0 has failed for the following properties:
+  - 'spec.service' with value 'web':
+    - v1alpha.Service 'web' does not exist
+Validation for v1.AlertPolicy 'my-alert-policy' at index 2 has failed for the following properties:
+  - 'spec.conditions[0].conditionRef' with value 'cpu-usage-breach':
+    - v1.AlertCondition 'cpu-usage-breach' does not exist
+  - 'spec.notificationTargets[0].targetRef' with value 'devs-email-notification':
+    - v1.AlertNotificationTarget 'devs-email-notification' does not exist
+Validation for v1.SLO 'my-slo' at index 3 has failed for the following properties:
+  - 'spec.objectives[0].indicator.spec.ratioMetric.good.metricSource.metricSourceRef' with value 'my-prometheus':
+    - v1.DataSource 'my-prometheus' does not exist
+  - 'spec.objectives[0].indicator.spec.ratioMetric.total.metricSource.metricSourceRef' with value 'my-prometheus':
+    - v1.DataSource 'my-prometheus' does not exist
+  - 'spec.objectives[1].indicatorRef' with value 'my-other-sli':
+    - v1.SLI 'my-other-sli' does not exist
+  - 'spec.alertPolicies[1].alertPolicyRef' with value 'my-missing-alert-policy':
+    - v1.AlertPolicy 'my-missing-alert-policy' does not exist
+Validation for v2alpha.SLO 'my-slo' at index 5 has failed for the following properties:
+  - 'spec.serviceRef' with value 'web':
+    - v2alpha.Service 'web' does not exist
+  - 'spec.sliRef' with value 'my-sli':
+    - v2alpha.SLI 'my-sli' does not exist
+  - 'spec.alertPolicies[0].spec.notificationTargets[0].targetRef' with value 'devs-email-notification':
+    - v2alpha.AlertNotificationTarget 'devs-email-notification' does not exist`
+		assert.Equal(t, expectedError, err.Error())
+	})
+	t.Run("duplicate objects", func(t *testing.T) {
+		objects, err := Decode(bytes.NewReader(readTestData(t, testData, "validate_set/duplicates.yaml")), FormatYAML)
+		assert.Require(t, assert.NoError(t, err))
+		assert.Require(t, assert.NoError(t, Validate(objects...)))
+
+		err = ValidateSet(objects...)
+
+		assert.Require(t, assert.Error(t, err))
+		assert.True(t, govy.HasErrorCode(err, ErrorCodeDuplicateObject))
+		expectedError := `Validation for v1.Service 'web' at index 0 has failed for the following properties:
+  - 'metadata.name' with value 'web':
+    - v1.Service 'web' is defined more than once, at indexes: 0, 3
+Validation for v1.Service 'web' at index 3 has failed for the following properties:
+  - 'metadata.name' with value 'web':
+    - v1.Service 'web' is defined more than once, at indexes: 0, 3`
+		assert.Equal(t, expectedError, err.Error())
+	})
+}
+
+func TestValidateWithPositions(t *testing.T) {
+	data := `- apiVersion: openslo/v1
+  kind: Service
+  metadata:
+    name: valid
+- apiVersion: openslo/v1
+  kind: Service
+  metadata:
+    name: Invalid
+`
+	dec := NewDecoder(bytes.NewBufferString(data), FormatYAML).WithFileName("services.yaml")
+	var (
+		objects   []openslo.Object
+		positions []Position
+	)
+	for {
+		object, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		assert.Require(t, assert.NoError(t, err))
+		objects = append(objects, object)
+		positions = append(positions, dec.Position())
+	}
+
+	err := ValidateWithPositions(objects, positions)
+
+	assert.Require(t, assert.Error(t, err))
+	var vErrs govy.ValidatorErrors
+	assert.Require(t, assert.True(t, errors.As(err, &vErrs)))
+	assert.Require(t, assert.Len(t, vErrs, 1))
+	assert.Equal(t, "v1.Service 'Invalid' (services.yaml:5)", vErrs[0].Name)
+	assert.Equal(t, 5, positions[*vErrs[0].SliceIndex].Line)
+
+	err = ValidateWithPositions(objects, positions[:1])
+	assert.Require(t, assert.Error(t, err))
+	assert.Equal(t, "number of objects (2) does not match the number of positions (1)", err.Error())
+}
@@ -0,0 +1,3 @@
+// Package prometheus generates Prometheus recording and alerting rules from OpenSLO objects.
+// The generated rule groups can be encoded into a rules file compatible with 'promtool check rules'.
+package prometheus
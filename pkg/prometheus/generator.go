@@ -0,0 +1,418 @@
+package prometheus
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo/budget"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslosdk"
+)
+
+// MetricSourceType is the [v1.SLIMetricSource] type supported by [Generator].
+const MetricSourceType = "Prometheus"
+
+// WindowPlaceholder can be used in queries to control where the range selector is placed.
+// If the query does not contain it, the query is wrapped in a subquery instead.
+// Example:
+//
+//	sum(rate(http_requests_total{code=~"5.."}[{{.window}}]))
+const WindowPlaceholder = "{{.window}}"
+
+// Labels added by [Generator] to every generated rule.
+const (
+	LabelSLO       = "openslo_slo"
+	LabelService   = "openslo_service"
+	LabelObjective = "openslo_objective"
+)
+
+// sliErrorRecordPrefix is the prefix of the recorded SLI error ratio metric name,
+// the window is appended to it, e.g. 'openslo:sli_error:ratio_rate5m'.
+const sliErrorRecordPrefix = "openslo:sli_error:ratio_rate"
+
+// NewGenerator creates a new [Generator] with the default windows:
+// 5m, 30m, 1h, 2h, 6h, 1d and 3d.
+func NewGenerator() *Generator {
+	return &Generator{
+		windows: []v1.DurationShorthand{
+			v1.NewDurationShorthand(5, v1.DurationShorthandUnitMinute),
+			v1.NewDurationShorthand(30, v1.DurationShorthandUnitMinute),
+			v1.NewDurationShorthand(1, v1.DurationShorthandUnitHour),
+			v1.NewDurationShorthand(2, v1.DurationShorthandUnitHour),
+			v1.NewDurationShorthand(6, v1.DurationShorthandUnitHour),
+			v1.NewDurationShorthand(1, v1.DurationShorthandUnitDay),
+			v1.NewDurationShorthand(3, v1.DurationShorthandUnitDay),
+		},
+	}
+}
+
+// Generator generates Prometheus rules for [v1.SLO].
+//
+// For every window, a recording rule is generated which computes the ratio of bad to total events.
+// Lookback windows of the SLO's burn rate [v1.AlertCondition] are always recorded,
+// in addition to the windows configured with [Generator.WithWindows].
+// Every burn rate [v1.AlertCondition] is translated into an alerting rule,
+// which compares the recorded error ratio divided by the objective's error budget with the condition's threshold.
+// If the [v1.AlertCondition] defines [openslosdk.ShortWindowAnnotation], as the ones created by
+// [openslosdk.NewMultiBurnRateAlerts] do, the threshold has to be exceeded in both the lookback and short windows.
+type Generator struct {
+	windows []v1.DurationShorthand
+}
+
+// WithWindows overrides the default windows for which the SLI error ratio is recorded.
+func (g *Generator) WithWindows(windows ...v1.DurationShorthand) *Generator {
+	g.windows = windows
+	return g
+}
+
+// Generate creates a [RuleGroup] for the provided [v1.SLO].
+// The [v1.SLO] is validated first and an error is returned if it is not valid.
+//
+// The [v1.SLO] must be fully inlined, which means its [v1.SLI], [v1.AlertPolicy] and [v1.AlertCondition]
+// must be defined inline rather than referenced (see openslosdk.ReferenceInliner).
+// SLI metrics must have the [MetricSourceType] and define the query under the 'query' key of the metric source spec.
+// Composite SLOs are not supported.
+//
+// Regardless of the budgeting method, the error ratio is computed as the ratio of bad to total events.
+func (g *Generator) Generate(slo v1.SLO) (RuleGroup, error) {
+	if err := slo.Validate(); err != nil {
+		return RuleGroup{}, err
+	}
+	if err := checkInlined(slo); err != nil {
+		return RuleGroup{}, fmt.Errorf("failed to generate Prometheus rules for %s: %w", slo, err)
+	}
+	group, err := g.generateRuleGroup(slo)
+	if err != nil {
+		return RuleGroup{}, fmt.Errorf("failed to generate Prometheus rules for %s: %w", slo, err)
+	}
+	return group, nil
+}
+
+// checkInlined verifies that all the objects required to generate the rules are defined inline.
+func checkInlined(slo v1.SLO) error {
+	switch {
+	case slo.Spec.HasCompositeObjectives():
+		return errors.New("composite SLOs are not supported")
+	case slo.Spec.IndicatorRef != nil:
+		return errors.New("'spec.indicatorRef' must be inlined")
+	case slo.Spec.Indicator == nil:
+		return errors.New("'spec.indicator' is required")
+	}
+	for i, alertPolicy := range slo.Spec.AlertPolicies {
+		if alertPolicy.SLOAlertPolicyInline == nil {
+			return fmt.Errorf("'spec.alertPolicies[%d].alertPolicyRef' must be inlined", i)
+		}
+		for j, condition := range alertPolicy.Spec.Conditions {
+			if condition.AlertPolicyConditionInline == nil {
+				return fmt.Errorf("'spec.alertPolicies[%d].spec.conditions[%d].conditionRef' must be inlined", i, j)
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Generator) generateRuleGroup(slo v1.SLO) (RuleGroup, error) {
+	windows := g.getWindows(slo)
+	group := RuleGroup{Name: "openslo:" + slo.Metadata.Name}
+	var err error
+	if slo.Spec.Indicator.Spec.ThresholdMetric != nil {
+		// Threshold metrics are compared with each objective's value,
+		// which means the error ratio has to be recorded separately for every objective.
+		for i, objective := range slo.Spec.Objectives {
+			group.Rules, err = g.appendRecordingRules(group.Rules, slo, objective, i, windows)
+			if err != nil {
+				return RuleGroup{}, err
+			}
+		}
+	} else {
+		group.Rules, err = g.appendRecordingRules(group.Rules, slo, v1.SLOObjective{}, -1, windows)
+		if err != nil {
+			return RuleGroup{}, err
+		}
+	}
+	for i, objective := range slo.Spec.Objectives {
+		group.Rules, err = g.appendAlertingRules(group.Rules, slo, objective, i)
+		if err != nil {
+			return RuleGroup{}, err
+		}
+	}
+	return group, nil
+}
+
+// appendRecordingRules appends error ratio recording rules for each window.
+// If objectiveIndex is negative, the rules are not bound to any specific objective.
+func (g *Generator) appendRecordingRules(
+	rules []Rule,
+	slo v1.SLO,
+	objective v1.SLOObjective,
+	objectiveIndex int,
+	windows []v1.DurationShorthand,
+) ([]Rule, error) {
+	labels := sloLabels(slo)
+	if objectiveIndex >= 0 {
+		labels[LabelObjective] = objectiveLabel(objective, objectiveIndex)
+	}
+	for _, window := range windows {
+		expr, err := errorRatioExpr(slo.Spec.Indicator.Spec, objective, promDuration(window))
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, Rule{
+			Record: sliErrorRecordPrefix + promDuration(window),
+			Expr:   expr,
+			Labels: maps.Clone(labels),
+		})
+	}
+	return rules, nil
+}
+
+// appendAlertingRules appends an alerting rule for each burn rate [v1.AlertCondition] of the SLO.
+func (g *Generator) appendAlertingRules(
+	rules []Rule,
+	slo v1.SLO,
+	objective v1.SLOObjective,
+	objectiveIndex int,
+) ([]Rule, error) {
+	budgetObjective, err := budget.NewV1Objective(slo, objectiveIndex)
+	if err != nil {
+		return nil, err
+	}
+	selector := fmt.Sprintf("%s=%q", LabelSLO, slo.Metadata.Name)
+	if slo.Spec.Indicator.Spec.ThresholdMetric != nil {
+		selector += fmt.Sprintf(",%s=%q", LabelObjective, objectiveLabel(objective, objectiveIndex))
+	}
+	for _, alertPolicy := range slo.Spec.AlertPolicies {
+		for _, condition := range alertPolicy.Spec.Conditions {
+			spec := condition.Spec.Condition
+			if spec.Kind != v1.AlertConditionKindBurnRate || spec.Threshold == nil {
+				continue
+			}
+			op, err := promOperator(spec.Operator)
+			if err != nil {
+				return nil, fmt.Errorf("alert condition '%s': %w", condition.Metadata.Name, err)
+			}
+			labels := sloLabels(slo)
+			labels[LabelObjective] = objectiveLabel(objective, objectiveIndex)
+			if condition.Spec.Severity != "" {
+				labels["severity"] = condition.Spec.Severity
+			}
+			burnRateExpr := func(window v1.DurationShorthand) string {
+				return fmt.Sprintf("%s%s{%s} / (1 - %s) %s %s",
+					sliErrorRecordPrefix, promDuration(window), selector,
+					formatFloat(budgetObjective.Target), op, formatFloat(*spec.Threshold))
+			}
+			expr := burnRateExpr(spec.LookbackWindow)
+			shortWindow, hasShortWindow, err := getShortWindow(condition.Metadata)
+			if err != nil {
+				return nil, fmt.Errorf("alert condition '%s': %w", condition.Metadata.Name, err)
+			}
+			if hasShortWindow {
+				expr = fmt.Sprintf("(%s) and (%s)", expr, burnRateExpr(shortWindow))
+			}
+			rule := Rule{
+				Alert:  condition.Metadata.Name,
+				Expr:   expr,
+				Labels: labels,
+				Annotations: map[string]string{
+					"summary": fmt.Sprintf("%s burn rate over %s", slo.Metadata.Name, spec.LookbackWindow),
+				},
+			}
+			if spec.AlertAfter != nil && spec.AlertAfter.GetValue() > 0 {
+				rule.For = promDuration(*spec.AlertAfter)
+			}
+			if condition.Spec.Description != "" {
+				rule.Annotations["description"] = condition.Spec.Description
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+// getWindows returns a sorted and deduplicated list of windows,
+// including the lookback windows of all burn rate alert conditions.
+func (g *Generator) getWindows(slo v1.SLO) []v1.DurationShorthand {
+	windows := slices.Clone(g.windows)
+	for _, alertPolicy := range slo.Spec.AlertPolicies {
+		for _, condition := range alertPolicy.Spec.Conditions {
+			if condition.Spec.Condition.Kind == v1.AlertConditionKindBurnRate &&
+				condition.Spec.Condition.LookbackWindow.GetValue() > 0 {
+				windows = append(windows, condition.Spec.Condition.LookbackWindow)
+			}
+			if shortWindow, ok, err := getShortWindow(condition.Metadata); ok && err == nil {
+				windows = append(windows, shortWindow)
+			}
+		}
+	}
+	slices.SortStableFunc(windows, func(a, b v1.DurationShorthand) int {
+		return cmp.Compare(a.Duration(), b.Duration())
+	})
+	return slices.CompactFunc(windows, func(a, b v1.DurationShorthand) bool {
+		return promDuration(a) == promDuration(b)
+	})
+}
+
+// getShortWindow returns the short window of a multi-window burn rate alert,
+// stored in the [openslosdk.ShortWindowAnnotation].
+func getShortWindow(metadata v1.Metadata) (window v1.DurationShorthand, ok bool, err error) {
+	value, ok := metadata.Annotations[openslosdk.ShortWindowAnnotation]
+	if !ok {
+		return window, false, nil
+	}
+	window, err = v1.ParseDurationShorthand(value)
+	if err == nil {
+		err = window.Validate()
+	}
+	if err != nil || window.GetValue() == 0 {
+		return window, false, fmt.Errorf("invalid '%s' annotation value: '%s'", openslosdk.ShortWindowAnnotation, value)
+	}
+	return window, true, nil
+}
+
+// errorRatioExpr creates a PromQL expression which computes the ratio of bad to total events over the window.
+func errorRatioExpr(spec v1.SLISpec, objective v1.SLOObjective, window string) (string, error) {
+	if spec.ThresholdMetric != nil {
+		query, err := metricQuery(spec.ThresholdMetric, "thresholdMetric")
+		if err != nil {
+			return "", err
+		}
+		op, err := promOperator(objective.Operator)
+		if err != nil {
+			return "", err
+		}
+		if objective.Value == nil {
+			return "", errors.New("objective 'value' is required for threshold metric")
+		}
+		return fmt.Sprintf("1 - avg_over_time(((%s) %s bool %s)[%s:])",
+			query, op, formatFloat(*objective.Value), window), nil
+	}
+	ratio := spec.RatioMetric
+	if ratio == nil {
+		return "", errors.New("either 'thresholdMetric' or 'ratioMetric' must be set")
+	}
+	counter := func(metric *v1.SLIMetricSpec, name string) (string, error) {
+		query, err := metricQuery(metric, "ratioMetric."+name)
+		if err != nil {
+			return "", err
+		}
+		return rangeExpr(query, window, ratio.Counter), nil
+	}
+	switch {
+	case ratio.Raw != nil:
+		query, err := metricQuery(ratio.Raw, "ratioMetric.raw")
+		if err != nil {
+			return "", err
+		}
+		expr := windowQuery(query, window, "avg_over_time")
+		if ratio.RawType == v1.SLIRawMetricTypeFailure {
+			return expr, nil
+		}
+		return "1 - " + expr, nil
+	case ratio.Bad != nil:
+		bad, err := counter(ratio.Bad, "bad")
+		if err != nil {
+			return "", err
+		}
+		total, err := counter(ratio.Total, "total")
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s) / (%s)", bad, total), nil
+	default:
+		good, err := counter(ratio.Good, "good")
+		if err != nil {
+			return "", err
+		}
+		total, err := counter(ratio.Total, "total")
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("1 - (%s) / (%s)", good, total), nil
+	}
+}
+
+// rangeExpr aggregates the query over the window,
+// counters are aggregated with increase, while gauges are summed.
+func rangeExpr(query, window string, counter bool) string {
+	if counter {
+		return windowQuery(query, window, "increase")
+	}
+	return windowQuery(query, window, "sum_over_time")
+}
+
+// windowQuery applies the window to the query.
+// If the query contains [WindowPlaceholder], the placeholder is replaced with the window and the query is used as is.
+// Otherwise, the query is wrapped in a subquery and passed to the provided range function.
+func windowQuery(query, window, function string) string {
+	if strings.Contains(query, WindowPlaceholder) {
+		return strings.ReplaceAll(query, WindowPlaceholder, window)
+	}
+	return fmt.Sprintf("%s((%s)[%s:])", function, query, window)
+}
+
+func metricQuery(metric *v1.SLIMetricSpec, path string) (string, error) {
+	if metric == nil {
+		return "", fmt.Errorf("'%s' is required", path)
+	}
+	if !strings.EqualFold(metric.MetricSource.Type, MetricSourceType) {
+		return "", fmt.Errorf("'%s' metric source type must be %s, got: '%s'",
+			path, MetricSourceType, metric.MetricSource.Type)
+	}
+	// The presence of the query is ensured by the metric source spec validation.
+	query, _ := metric.MetricSource.Spec["query"].(string)
+	return strings.TrimSpace(query), nil
+}
+
+func objectiveLabel(objective v1.SLOObjective, index int) string {
+	if objective.DisplayName != "" {
+		return objective.DisplayName
+	}
+	return strconv.Itoa(index)
+}
+
+func sloLabels(slo v1.SLO) map[string]string {
+	return map[string]string{
+		LabelSLO:     slo.Metadata.Name,
+		LabelService: slo.Spec.Service,
+	}
+}
+
+func promOperator(op v1.Operator) (string, error) {
+	switch op {
+	case v1.OperatorGT:
+		return ">", nil
+	case v1.OperatorGTE:
+		return ">=", nil
+	case v1.OperatorLT:
+		return "<", nil
+	case v1.OperatorLTE:
+		return "<=", nil
+	default:
+		return "", fmt.Errorf("unsupported operator: '%s'", op)
+	}
+}
+
+// promDuration converts [v1.DurationShorthand] into Prometheus duration.
+// Months and quarters are not supported by Prometheus and are approximated the same way as
+// [v1.DurationShorthand.Duration] does, while a Prometheus year is always 365 days.
+func promDuration(d v1.DurationShorthand) string {
+	switch d.GetUnit() {
+	case v1.DurationShorthandUnitMonth:
+		return strconv.Itoa(d.GetValue()*30) + "d"
+	case v1.DurationShorthandUnitQuarter:
+		return strconv.Itoa(d.GetValue()*90) + "d"
+	case v1.DurationShorthandUnitYear:
+		return strconv.Itoa(d.GetValue()) + "y"
+	default:
+		return d.String()
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,113 @@
+package prometheus
+
+import (
+	"bytes"
+	"embed"
+	"path/filepath"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/internal/assert"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslosdk"
+)
+
+//go:embed test_data
+var testData embed.FS
+
+func TestGenerator_Generate(t *testing.T) {
+	objects, err := openslosdk.Decode(bytes.NewReader(readTestData(t, "slos.yaml")), openslosdk.FormatYAML)
+	assert.Require(t, assert.NoError(t, err))
+	objects, err = openslosdk.NewReferenceInliner(objects...).RemoveReferencedObjects().Inline()
+	assert.Require(t, assert.NoError(t, err))
+	slos := openslosdk.FilterByType[v1.SLO](objects)
+	assert.Require(t, assert.Len(t, slos, 2))
+
+	generator := NewGenerator().WithWindows(
+		v1.NewDurationShorthand(5, v1.DurationShorthandUnitMinute),
+		v1.NewDurationShorthand(1, v1.DurationShorthandUnitHour),
+	)
+	groups := make([]RuleGroup, 0, len(slos))
+	for _, slo := range slos {
+		group, err := generator.Generate(slo)
+		assert.Require(t, assert.NoError(t, err))
+		groups = append(groups, group)
+	}
+
+	var buf bytes.Buffer
+	err = Encode(&buf, groups...)
+	assert.Require(t, assert.NoError(t, err))
+	assert.Equal(t, string(readTestData(t, "rules.yaml")), buf.String())
+}
+
+func TestGenerator_Generate_Errors(t *testing.T) {
+	metric := func(sourceType string, spec map[string]any) *v1.SLIMetricSpec {
+		return &v1.SLIMetricSpec{MetricSource: v1.SLIMetricSource{Type: sourceType, Spec: spec}}
+	}
+	newSLO := func(indicator *v1.SLOIndicatorInline) v1.SLO {
+		return v1.NewSLO(v1.Metadata{Name: "my-slo"}, v1.SLOSpec{
+			Service:         "web",
+			Indicator:       indicator,
+			BudgetingMethod: v1.SLOBudgetingMethodOccurrences,
+			TimeWindow: []v1.SLOTimeWindow{{
+				Duration:  v1.NewDurationShorthand(1, v1.DurationShorthandUnitWeek),
+				IsRolling: true,
+			}},
+			Objectives: []v1.SLOObjective{{Target: ptr(0.99)}},
+		})
+	}
+	tests := map[string]struct {
+		slo v1.SLO
+		err string
+	}{
+		"indicator reference": {
+			slo: func() v1.SLO {
+				slo := newSLO(nil)
+				slo.Spec.IndicatorRef = ptr("my-sli")
+				return slo
+			}(),
+			err: "failed to generate Prometheus rules for v1.SLO 'my-slo': 'spec.indicatorRef' must be inlined",
+		},
+		"unsupported metric source type": {
+			slo: newSLO(&v1.SLOIndicatorInline{
+				Metadata: v1.Metadata{Name: "my-sli"},
+				Spec: v1.SLISpec{RatioMetric: &v1.SLIRatioMetric{
+					Good:  metric("Datadog", map[string]any{"query": "good"}),
+					Total: metric("Datadog", map[string]any{"query": "total"}),
+				}},
+			}),
+			err: "failed to generate Prometheus rules for v1.SLO 'my-slo':" +
+				" 'ratioMetric.good' metric source type must be Prometheus, got: 'Datadog'",
+		},
+		"missing query": {
+			slo: newSLO(&v1.SLOIndicatorInline{
+				Metadata: v1.Metadata{Name: "my-sli"},
+				Spec: v1.SLISpec{RatioMetric: &v1.SLIRatioMetric{
+					Counter: true,
+					Bad:     metric("Prometheus", map[string]any{"promql": "bad"}),
+					Total:   metric("Prometheus", map[string]any{"query": "total"}),
+				}},
+			}),
+			err: "Validation for v1.SLO 'my-slo' has failed for the following properties:\n" +
+				"  - 'spec.indicator.spec.ratioMetric.bad.metricSource.spec.query':\n" +
+				"    - property is required but was empty",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewGenerator().Generate(test.slo)
+			assert.Require(t, assert.Error(t, err))
+			assert.Equal(t, test.err, err.Error())
+		})
+	}
+}
+
+func readTestData(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := testData.ReadFile(filepath.Join("test_data", path))
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+	return data
+}
+
+func ptr[T any](v T) *T { return &v }
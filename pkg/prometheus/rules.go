@@ -0,0 +1,39 @@
+package prometheus
+
+import (
+	"io"
+
+	"sigs.k8s.io/yaml"
+)
+
+// RuleFile is a Prometheus rules file.
+type RuleFile struct {
+	Groups []RuleGroup `json:"groups"`
+}
+
+// RuleGroup is a named group of Prometheus rules, evaluated sequentially.
+type RuleGroup struct {
+	Name     string `json:"name"`
+	Interval string `json:"interval,omitempty"`
+	Rules    []Rule `json:"rules"`
+}
+
+// Rule is either a Prometheus recording rule (if Record is set) or an alerting rule (if Alert is set).
+type Rule struct {
+	Record      string            `json:"record,omitempty"`
+	Alert       string            `json:"alert,omitempty"`
+	Expr        string            `json:"expr"`
+	For         string            `json:"for,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Encode writes the provided [RuleGroup] to [io.Writer] as a YAML Prometheus rules file.
+func Encode(out io.Writer, groups ...RuleGroup) error {
+	data, err := yaml.Marshal(RuleFile{Groups: groups})
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(data)
+	return err
+}